@@ -0,0 +1,89 @@
+package sportsdata
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// BatchError reports the items of a batch that failed. Errors is indexed like
+// the batch input and holds nil for items that succeeded.
+type BatchError struct {
+	Errors []error
+}
+
+// NewBatchError returns a *BatchError for errs, or nil if every item
+// succeeded.
+func NewBatchError(errs []error) error {
+	for _, err := range errs {
+		if err != nil {
+			return &BatchError{Errors: errs}
+		}
+	}
+	return nil
+}
+
+// Failed returns the number of items that failed.
+func (e *BatchError) Failed() int {
+	failed := 0
+	for _, err := range e.Errors {
+		if err != nil {
+			failed++
+		}
+	}
+	return failed
+}
+
+func (e *BatchError) Error() string {
+	for _, err := range e.Errors {
+		if err != nil {
+			return fmt.Sprintf("%d of %d requests failed, first error: %s", e.Failed(), len(e.Errors), err.Error())
+		}
+	}
+	return fmt.Sprintf("0 of %d requests failed", len(e.Errors))
+}
+
+// Unwrap returns the errors of the failed items for errors.Is and errors.As.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, 0)
+	for _, err := range e.Errors {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// ForEach calls fn for every index in [0, n) from up to workers goroutines
+// and waits for them to finish. Items not started when ctx is done fail with
+// its error. If any item fails, ForEach returns a *BatchError.
+func ForEach(ctx context.Context, n, workers int, fn func(ctx context.Context, i int) error) error {
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > n {
+		workers = n
+	}
+	errs := make([]error, n)
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := ctx.Err(); err != nil {
+					errs[i] = err
+					continue
+				}
+				errs[i] = fn(ctx, i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return NewBatchError(errs)
+}
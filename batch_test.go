@@ -0,0 +1,67 @@
+package sportsdata
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestForEach(t *testing.T) {
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	results := make([]int, 10)
+	err := ForEach(context.Background(), len(results), 3, func(ctx context.Context, i int) error {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		if i == 4 {
+			return ErrNotFound
+		}
+		results[i] = i * i
+		return nil
+	})
+	if maxRunning > 3 {
+		t.Errorf("Expected at most %d workers, found %d\n", 3, maxRunning)
+		return
+	}
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Errorf("Expected *BatchError, found %v\n", err)
+		return
+	}
+	if batchErr.Failed() != 1 || batchErr.Errors[4] != ErrNotFound {
+		t.Errorf("Expected item %d to fail, found %v\n", 4, batchErr.Errors)
+		return
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected error to match %v\n", ErrNotFound)
+		return
+	}
+	if results[9] != 81 {
+		t.Errorf("Expected result %d, found %d\n", 81, results[9])
+		return
+	}
+}
+
+func TestForEachCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	calls := 0
+	err := ForEach(ctx, 3, 1, func(ctx context.Context, i int) error {
+		calls++
+		return nil
+	})
+	if calls != 0 || !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected no calls and error %v, found %d calls and %v\n", context.Canceled, calls, err)
+		return
+	}
+}
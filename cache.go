@@ -0,0 +1,196 @@
+package sportsdata
+
+import (
+	"container/list"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// CacheForever is the TTL of responses that never go stale.
+const CacheForever = time.Duration(1<<63 - 1)
+
+// CacheEntry is a cached response body with the validators needed to
+// revalidate it.
+type CacheEntry struct {
+	Body         []byte    `json:"body"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	Expires      time.Time `json:"expires"`
+}
+
+// NewCacheEntry returns an entry for the body of resp that stays fresh for
+// ttl.
+func NewCacheEntry(resp *http.Response, body []byte, ttl time.Duration) *CacheEntry {
+	e := &CacheEntry{
+		Body:         body,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}
+	e.Refresh(ttl)
+	return e
+}
+
+// Fresh reports whether the entry may be used without revalidation.
+func (e *CacheEntry) Fresh(now time.Time) bool {
+	return e.Expires.IsZero() || now.Before(e.Expires)
+}
+
+// Refresh makes the entry fresh for another ttl.
+func (e *CacheEntry) Refresh(ttl time.Duration) {
+	if ttl == CacheForever {
+		e.Expires = time.Time{}
+	} else {
+		e.Expires = time.Now().Add(ttl)
+	}
+}
+
+// Revalidate adds conditional headers to req so that the server answers 304
+// Not Modified if the entry is still current.
+func (e *CacheEntry) Revalidate(req *http.Request) {
+	if e.ETag != "" {
+		req.Header.Set("If-None-Match", e.ETag)
+	}
+	if e.LastModified != "" {
+		req.Header.Set("If-Modified-Since", e.LastModified)
+	}
+}
+
+// Cache stores response bodies by request URL, without the api key.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+}
+
+// CacheTTL returns how long a response from endpoint, decoded into v, stays
+// fresh. A TTL of zero or less is not cached. v is nil for streamed responses.
+type CacheTTL func(endpoint Endpoint, v interface{}) time.Duration
+
+// DefaultCacheTTL keeps hierarchies for a week, rosters and team profiles for
+// a day, schedules for an hour, closed games forever and anything else for
+// ten seconds.
+func DefaultCacheTTL(endpoint Endpoint, v interface{}) time.Duration {
+	if c, ok := v.(interface {
+		Closed() bool
+	}); ok && c.Closed() {
+		return CacheForever
+	}
+	switch endpoint {
+	case EndpointHierarchy:
+		return 7 * 24 * time.Hour
+	case EndpointRoster, EndpointProfile:
+		return 24 * time.Hour
+	case EndpointSchedule:
+		return time.Hour
+	}
+	return 10 * time.Second
+}
+
+// MemoryCache is a Cache holding a bounded number of entries in memory,
+// evicting the least recently used.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	order      *list.List
+	entries    map[string]*list.Element
+}
+
+type memoryCacheItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewMemoryCache returns a MemoryCache holding up to maxEntries entries.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+func (c *MemoryCache) Get(key string) (*CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*memoryCacheItem).entry, true
+}
+
+func (c *MemoryCache) Set(key string, entry *CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		e.Value.(*memoryCacheItem).entry = entry
+		c.order.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&memoryCacheItem{key: key, entry: entry})
+	for c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*memoryCacheItem).key)
+	}
+}
+
+// DiskCache is a Cache storing one file per entry in a directory. Errors
+// reading or writing entries are treated as cache misses.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache returns a DiskCache in dir, which is created if needed.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+func (c *DiskCache) filename(key string) string {
+	sum := sha1.Sum([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (c *DiskCache) Get(key string) (*CacheEntry, bool) {
+	data, err := ioutil.ReadFile(c.filename(key))
+	if err != nil {
+		return nil, false
+	}
+	entry := new(CacheEntry)
+	if err := json.Unmarshal(data, entry); err != nil {
+		return nil, false
+	}
+	return entry, true
+}
+
+func (c *DiskCache) Set(key string, entry *CacheEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	tmp, err := ioutil.TempFile(c.dir, ".entry")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), c.filename(key))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+}
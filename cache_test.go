@@ -0,0 +1,87 @@
+package sportsdata
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestMemoryCache(t *testing.T) {
+	c := NewMemoryCache(2)
+	c.Set("a", &CacheEntry{Body: []byte("a")})
+	c.Set("b", &CacheEntry{Body: []byte("b")})
+	if _, ok := c.Get("a"); !ok {
+		t.Errorf("Expected entry %s\n", "a")
+		return
+	}
+	c.Set("c", &CacheEntry{Body: []byte("c")})
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("Expected least recently used entry %s to be evicted\n", "b")
+		return
+	}
+	entry, ok := c.Get("a")
+	if !ok || string(entry.Body) != "a" {
+		t.Errorf("Expected entry %s, found %+v\n", "a", entry)
+		return
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cache")
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	defer os.RemoveAll(dir)
+	c, err := NewDiskCache(dir)
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	if _, ok := c.Get("key"); ok {
+		t.Errorf("Expected cache miss\n")
+		return
+	}
+	c.Set("key", &CacheEntry{Body: []byte("<league/>"), ETag: `"1"`})
+	entry, ok := c.Get("key")
+	if !ok {
+		t.Errorf("Expected cache hit\n")
+		return
+	}
+	if string(entry.Body) != "<league/>" || entry.ETag != `"1"` {
+		t.Errorf("Expected stored entry, found %+v\n", entry)
+		return
+	}
+	if !entry.Fresh(time.Now()) {
+		t.Errorf("Expected entry without expiry to be fresh\n")
+		return
+	}
+}
+
+type closedValue bool
+
+func (c closedValue) Closed() bool {
+	return bool(c)
+}
+
+func TestDefaultCacheTTL(t *testing.T) {
+	if ttl := DefaultCacheTTL(EndpointBoxscore, closedValue(true)); ttl != CacheForever {
+		t.Errorf("Expected closed boxscore TTL %v, found %v\n", CacheForever, ttl)
+		return
+	}
+	if ttl := DefaultCacheTTL(EndpointBoxscore, closedValue(false)); ttl != 10*time.Second {
+		t.Errorf("Expected live boxscore TTL %v, found %v\n", 10*time.Second, ttl)
+		return
+	}
+	if ttl := DefaultCacheTTL(EndpointProfile, nil); ttl != 24*time.Hour {
+		t.Errorf("Expected team profile TTL %v, found %v\n", 24*time.Hour, ttl)
+		return
+	}
+	entry := &CacheEntry{}
+	entry.Refresh(CacheForever)
+	if !entry.Fresh(time.Now().Add(100 * 365 * 24 * time.Hour)) {
+		t.Errorf("Expected entry cached forever to be fresh\n")
+		return
+	}
+}
@@ -0,0 +1,51 @@
+package sportsdata
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// Decode decodes the document read from r in format f into v.
+func (f Format) Decode(r io.Reader, v interface{}) error {
+	switch f {
+	case FormatXML:
+		return xml.NewDecoder(r).Decode(v)
+	case FormatJSON:
+		return json.NewDecoder(r).Decode(v)
+	}
+	return fmt.Errorf("Unsupported format %q", string(f))
+}
+
+// EachStartElement reads the XML document from r and calls fn for every start
+// element. If fn consumes the element with d.DecodeElement or d.Skip, its
+// children are not visited. Iteration stops at the first error returned by
+// fn.
+func EachStartElement(r io.Reader, fn func(d *xml.Decoder, start xml.StartElement) error) error {
+	d := xml.NewDecoder(r)
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			if err := fn(d, start); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Attr returns the value of the attribute named local on start.
+func Attr(start xml.StartElement, local string) string {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == local {
+			return attr.Value
+		}
+	}
+	return ""
+}
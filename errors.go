@@ -0,0 +1,116 @@
+package sportsdata
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+var (
+	ErrNotFound     = errors.New("Not found")
+	ErrUnauthorized = errors.New("Unauthorized")
+	ErrRateLimited  = errors.New("Rate limited")
+	ErrServer       = errors.New("Server error")
+)
+
+// Endpoint identifies the kind of feed a request was made to.
+type Endpoint string
+
+const (
+	EndpointHierarchy  = Endpoint("hierarchy")
+	EndpointSchedule   = Endpoint("schedule")
+	EndpointBoxscore   = Endpoint("boxscore")
+	EndpointStatistics = Endpoint("statistics")
+	EndpointPlayByPlay = Endpoint("pbp")
+	EndpointRoster     = Endpoint("roster")
+	EndpointSummary    = Endpoint("summary")
+	EndpointProfile    = Endpoint("profile")
+)
+
+// maxErrorBody is the number of response body bytes kept by an APIError.
+const maxErrorBody = 512
+
+// APIError is returned when the API responds with a status other than 200 OK.
+// It matches ErrNotFound, ErrUnauthorized, ErrRateLimited or ErrServer with
+// errors.Is depending on its status code.
+type APIError struct {
+	StatusCode int
+	Endpoint   Endpoint
+	// URL is the request URL with the api_key parameter redacted.
+	URL string
+	// Body is the beginning of the response body with the api key redacted.
+	Body string
+}
+
+// NewAPIError builds an APIError from resp, reading at most a few hundred
+// bytes of its body. The caller still closes the body.
+func NewAPIError(endpoint Endpoint, resp *http.Response) *APIError {
+	e := &APIError{
+		StatusCode: resp.StatusCode,
+		Endpoint:   endpoint,
+	}
+	var apiKey string
+	if resp.Request != nil && resp.Request.URL != nil {
+		e.URL = RedactURL(resp.Request.URL)
+		apiKey = resp.Request.URL.Query().Get("api_key")
+	}
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	e.Body = string(body)
+	if apiKey != "" {
+		e.Body = strings.Replace(e.Body, apiKey, "REDACTED", -1)
+	}
+	return e
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API Status Returned Code %d for %s endpoint %s: %s", e.StatusCode, e.Endpoint, e.URL, e.Body)
+}
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return e.StatusCode >= 500
+	}
+	return false
+}
+
+// RedactURL returns u as a string with the value of its api_key parameter
+// replaced.
+func RedactURL(u *url.URL) string {
+	q := u.Query()
+	if q.Get("api_key") == "" {
+		return u.String()
+	}
+	q.Set("api_key", "REDACTED")
+	redacted := *u
+	redacted.RawQuery = q.Encode()
+	return redacted.String()
+}
+
+// RedactError removes the api key from the URL carried by a *url.Error, as
+// returned by http.Client when a request fails.
+func RedactError(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+	u, parseErr := url.Parse(urlErr.URL)
+	if parseErr != nil {
+		return err
+	}
+	return &url.Error{
+		Op:  urlErr.Op,
+		URL: RedactURL(u),
+		Err: urlErr.Err,
+	}
+}
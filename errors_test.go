@@ -0,0 +1,61 @@
+package sportsdata
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	u, err := url.Parse("https://api.sportsdatallc.org/ncaafb-t1/2014/REG/schedule.xml?api_key=secret")
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	resp := &http.Response{
+		StatusCode: http.StatusForbidden,
+		Body:       ioutil.NopCloser(strings.NewReader("<h1>Invalid key secret</h1>" + strings.Repeat(".", 1000))),
+		Request:    &http.Request{URL: u},
+	}
+	err = NewAPIError(EndpointSchedule, resp)
+	if strings.Contains(err.Error(), "secret") {
+		t.Errorf("Expected api key to be redacted, found %s\n", err.Error())
+		return
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Errorf("Expected *APIError, found %T\n", err)
+		return
+	}
+	if apiErr.Endpoint != EndpointSchedule {
+		t.Errorf("Expected endpoint %s, found %s\n", EndpointSchedule, apiErr.Endpoint)
+		return
+	}
+	if !strings.HasPrefix(apiErr.Body, "<h1>Invalid key REDACTED</h1>") || strings.Count(apiErr.Body, ".") != maxErrorBody-len("<h1>Invalid key secret</h1>") {
+		t.Errorf("Expected truncated body, found %s\n", apiErr.Body)
+		return
+	}
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("Expected error to match %v\n", ErrUnauthorized)
+		return
+	}
+	if errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected error not to match %v\n", ErrNotFound)
+		return
+	}
+}
+
+func TestRedactError(t *testing.T) {
+	err := RedactError(&url.Error{
+		Op:  "Get",
+		URL: "https://api.sportsdatallc.org/ncaamb-t3/league/hierarchy.xml?api_key=secret",
+		Err: errors.New("timeout"),
+	})
+	if strings.Contains(err.Error(), "secret") {
+		t.Errorf("Expected api key to be redacted, found %s\n", err.Error())
+		return
+	}
+}
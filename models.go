@@ -4,19 +4,31 @@ import (
 	"errors"
 )
 
+// DefaultBaseURL is the Sports Data API host used unless a client is given
+// another base URL.
+const DefaultBaseURL = "https://api.sportsdatallc.org"
+
 const SportsDataTimeFormat = "2006-01-02T15:04:05-07:00"
 
 var ErrScoreNotFound = errors.New("Score not found")
 
 type Venue struct {
-	Id        string `xml:"id,attr"`
-	Name      string `xml:"name,attr"`
-	Address   string `xml:"address,attr"`
-	City      string `xml:"city,attr"`
-	State     string `xml:"state,attr"`
-	Zip       string `xml:"zip,attr"`
-	Country   string `xml:"country,attr"`
-	Capacity  int64  `xml:"capacity,attr"`
-	Surface   string `xml:"surface,attr"`
-	VenueType string `xml:"type,attr"`
+	Id        string `xml:"id,attr" json:"id"`
+	Name      string `xml:"name,attr" json:"name"`
+	Address   string `xml:"address,attr" json:"address"`
+	City      string `xml:"city,attr" json:"city"`
+	State     string `xml:"state,attr" json:"state"`
+	Zip       string `xml:"zip,attr" json:"zip"`
+	Country   string `xml:"country,attr" json:"country"`
+	Capacity  int64  `xml:"capacity,attr" json:"capacity"`
+	Surface   string `xml:"surface,attr" json:"surface"`
+	VenueType string `xml:"type,attr" json:"type"`
 }
+
+// Format is the response format requested from the API.
+type Format string
+
+const (
+	FormatXML  = Format("xml")
+	FormatJSON = Format("json")
+)
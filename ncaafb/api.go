@@ -1,30 +1,166 @@
 package ncaafb
 
 import (
+	"bytes"
+	"context"
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
+	"path"
+	"strings"
 	"time"
+
+	"github.com/tassl-app/sportsdata"
 )
 
+// ErrUnsupportedLink is returned by Follow for links to feeds the package
+// has no model for.
+var ErrUnsupportedLink = errors.New("Unsupported link")
+
 type API struct {
 	apiKey     string
 	production bool
 	log        bool
+	client     *http.Client
+	limiter    *sportsdata.RateLimiter
+	limiterSet bool
+	retry      *sportsdata.RetryPolicy
+	baseURL    string
+	version    string
+	format     sportsdata.Format
+	cache      sportsdata.Cache
+	cacheTTL   sportsdata.CacheTTL
+	workers    int
 }
 
 func NewAPI(apiKey string, production, log bool) *API {
-	return &API{
-		apiKey:     apiKey,
-		production: production,
-		log:        log,
+	return NewAPIWithOptions(apiKey, WithProduction(production), WithLog(log))
+}
+
+// Option configures an API created with NewAPIWithOptions.
+type Option func(*API)
+
+// WithProduction selects the production access level instead of trial.
+func WithProduction(production bool) Option {
+	return func(a *API) {
+		a.production = production
+	}
+}
+
+// WithLog enables logging of endpoints and requests.
+func WithLog(log bool) Option {
+	return func(a *API) {
+		a.log = log
+	}
+}
+
+// WithHTTPClient sets the client used for every request. Use it to configure
+// timeouts, proxies or TLS. The default is http.DefaultClient.
+func WithHTTPClient(client *http.Client) Option {
+	return func(a *API) {
+		if client != nil {
+			a.client = client
+		}
+	}
+}
+
+// WithRateLimiter sets the limiter every request waits on. Share one limiter
+// between clients that use the same account. A nil limiter disables rate
+// limiting. The default allows the rate of the selected access level.
+func WithRateLimiter(limiter *sportsdata.RateLimiter) Option {
+	return func(a *API) {
+		a.limiter = limiter
+		a.limiterSet = true
 	}
 }
 
+// WithRetryPolicy sets how failed requests are retried. The default, nil,
+// makes a single attempt.
+func WithRetryPolicy(retry *sportsdata.RetryPolicy) Option {
+	return func(a *API) {
+		a.retry = retry
+	}
+}
+
+// WithBaseURL sets the scheme and host, and optionally a path prefix, that
+// endpoints are built on, such as a caching proxy or an httptest.Server. The
+// default is sportsdata.DefaultBaseURL.
+func WithBaseURL(baseURL string) Option {
+	return func(a *API) {
+		a.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithVersion sets the API version. The default is DefaultVersion.
+func WithVersion(version string) Option {
+	return func(a *API) {
+		a.version = version
+	}
+}
+
+// WithFormat sets the response format requested and decoded. The default is
+// sportsdata.FormatXML.
+func WithFormat(format sportsdata.Format) Option {
+	return func(a *API) {
+		a.format = format
+	}
+}
+
+// WithCache stores responses in cache and revalidates stale entries with
+// ETag and Last-Modified when the server provides them. Requests answered from
+// the cache do not wait on the rate limiter. Streamed schedules are buffered
+// in memory when a cache is set.
+func WithCache(cache sportsdata.Cache) Option {
+	return func(a *API) {
+		a.cache = cache
+	}
+}
+
+// WithCacheTTL sets how long cached responses stay fresh. The default is
+// sportsdata.DefaultCacheTTL.
+func WithCacheTTL(ttl sportsdata.CacheTTL) Option {
+	return func(a *API) {
+		if ttl != nil {
+			a.cacheTTL = ttl
+		}
+	}
+}
+
+// WithConcurrency sets how many requests batch methods such as AllSchedules
+// make at once. They still wait on the shared rate limiter. The default is 1.
+func WithConcurrency(workers int) Option {
+	return func(a *API) {
+		a.workers = workers
+	}
+}
+
+func NewAPIWithOptions(apiKey string, opts ...Option) *API {
+	a := &API{
+		apiKey:   apiKey,
+		client:   http.DefaultClient,
+		baseURL:  sportsdata.DefaultBaseURL,
+		version:  DefaultVersion,
+		format:   sportsdata.FormatXML,
+		cacheTTL: sportsdata.DefaultCacheTTL,
+		workers:  1,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	if !a.limiterSet {
+		a.limiter = sportsdata.NewAccessLevelRateLimiter(a.production)
+	}
+	return a
+}
+
+// DefaultVersion is the version of the ncaafb API used unless WithVersion is given.
+const DefaultVersion = "1"
+
 type AccessLevelType string
 
 const (
@@ -71,7 +207,7 @@ func (a *API) baseEndpoint() string {
 	} else {
 		accessLevel = AccessLevelTrial
 	}
-	endpoint := fmt.Sprintf("https://api.sportsdatallc.org/ncaafb-%s1", string(accessLevel))
+	endpoint := fmt.Sprintf("%s/ncaafb-%s%s", a.baseURL, string(accessLevel), a.version)
 	if a.log {
 		log.Printf("base endpoint: %+v\n", endpoint)
 	}
@@ -79,7 +215,7 @@ func (a *API) baseEndpoint() string {
 }
 
 func (a *API) divisionEndpoint(divisionType DivisionType) (*url.URL, error) {
-	endpoint := fmt.Sprintf("%s/teams/%s/hierarchy.xml", a.baseEndpoint(), string(divisionType))
+	endpoint := fmt.Sprintf("%s/teams/%s/hierarchy.%s", a.baseEndpoint(), string(divisionType), string(a.format))
 	u, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, err
@@ -88,13 +224,13 @@ func (a *API) divisionEndpoint(divisionType DivisionType) (*url.URL, error) {
 	q.Set("api_key", a.apiKey)
 	u.RawQuery = q.Encode()
 	if a.log {
-		log.Printf("division endpoint: %+v\n", u.String())
+		log.Printf("division endpoint: %+v\n", sportsdata.RedactURL(u))
 	}
 	return u, nil
 }
 
 func (a *API) scheduleEndpoint(year string, scheduleType ScheduleType) (*url.URL, error) {
-	endpoint := fmt.Sprintf("%s/%s/%s/schedule.xml", a.baseEndpoint(), year, string(scheduleType))
+	endpoint := fmt.Sprintf("%s/%s/%s/schedule.%s", a.baseEndpoint(), year, string(scheduleType), string(a.format))
 	u, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, err
@@ -103,14 +239,13 @@ func (a *API) scheduleEndpoint(year string, scheduleType ScheduleType) (*url.URL
 	q.Set("api_key", a.apiKey)
 	u.RawQuery = q.Encode()
 	if a.log {
-		log.Printf("schedule endpoint: %v\n", u.String())
+		log.Printf("schedule endpoint: %v\n", sportsdata.RedactURL(u))
 	}
 	return u, nil
 }
 
-func (a *API) boxscoreEndpoint(year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*url.URL, error) {
-	//http(s)://api.sportsdatallc.org/ncaafb-[access_level][version]/[year]/[ncaafb_season]/[ncaafb_season_week]/[away_team]/[home_team]/boxscore.[format]?api_key=[your_api_key]
-	endpoint := fmt.Sprintf("%s/%s/%s/%s/%s/%s/boxscore.xml", a.baseEndpoint(), year, scheduleType, week, awayTeamId, homeTeamId)
+func (a *API) teamRosterEndpoint(teamId string) (*url.URL, error) {
+	endpoint := fmt.Sprintf("%s/teams/%s/roster.%s", a.baseEndpoint(), teamId, string(a.format))
 	u, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, err
@@ -119,67 +254,170 @@ func (a *API) boxscoreEndpoint(year string, scheduleType ScheduleType, week, awa
 	q.Set("api_key", a.apiKey)
 	u.RawQuery = q.Encode()
 	if a.log {
-		log.Printf("boxscore endpoint: %v\n", u.String())
+		log.Printf("team roster endpoint: %v\n", sportsdata.RedactURL(u))
 	}
 	return u, nil
 }
 
-func (a *API) Division(divisionType DivisionType) (*Division, error) {
-	u, err := a.divisionEndpoint(divisionType)
+// gameEndpoint returns the endpoint of a per-game feed such as boxscore or
+// statistics.
+func (a *API) gameEndpoint(year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string, feed sportsdata.Endpoint) (*url.URL, error) {
+	//http(s)://api.sportsdatallc.org/ncaafb-[access_level][version]/[year]/[ncaafb_season]/[ncaafb_season_week]/[away_team]/[home_team]/[feed].[format]?api_key=[your_api_key]
+	endpoint := fmt.Sprintf("%s/%s/%s/%s/%s/%s/%s.%s", a.baseEndpoint(), year, scheduleType, week, awayTeamId, homeTeamId, string(feed), string(a.format))
+	u, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, err
 	}
-	endpoint := u.String()
-	time.Sleep(1 * time.Second)
-	resp, err := http.Get(endpoint)
+	q := u.Query()
+	q.Set("api_key", a.apiKey)
+	u.RawQuery = q.Encode()
+	if a.log {
+		log.Printf("%s endpoint: %v\n", feed, sportsdata.RedactURL(u))
+	}
+	return u, nil
+}
+
+// linkEndpoint returns the endpoint of a feed linked from a schedule. Links
+// are relative to the base endpoint and name the XML feed, so the extension
+// is replaced by the configured format.
+func (a *API) linkEndpoint(link Link) (*url.URL, error) {
+	href, err := url.Parse(link.Href)
 	if err != nil {
 		return nil, err
 	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(fmt.Sprintf("API Status Returned Code %d.\nRequest: %+v\nResponse: %+v\n", resp.StatusCode, resp.Request, resp))
+	if href.IsAbs() || href.Host != "" {
+		return nil, fmt.Errorf("%w: %s is not relative", ErrUnsupportedLink, link.Href)
 	}
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
+	p := path.Clean("/" + href.Path)
+	p = strings.TrimSuffix(p, path.Ext(p)) + "." + string(a.format)
+	u, err := url.Parse(a.baseEndpoint() + p)
 	if err != nil {
 		return nil, err
 	}
-	division := new(Division)
-	err = xml.Unmarshal(body, division)
-	return division, err
+	q := u.Query()
+	q.Set("api_key", a.apiKey)
+	u.RawQuery = q.Encode()
+	if a.log {
+		log.Printf("%s link endpoint: %v\n", link.Rel, sportsdata.RedactURL(u))
+	}
+	return u, nil
 }
 
-func (a *API) AllDivisions() ([]*Division, error) {
-	divisions := make([]*Division, 0)
-	for _, divisionType := range DivisionAll {
-		division, err := a.Division(divisionType)
-		if err != nil {
+// fetch requests u, waiting on the rate limiter before every attempt, and
+// calls decode with the response body. If a cache is configured, fresh
+// entries are decoded without a request, stale ones are revalidated and the
+// response is stored for as long as the cache TTL gives for endpoint and v,
+// the value decode fills in.
+func (a *API) fetch(ctx context.Context, endpoint sportsdata.Endpoint, u *url.URL, v interface{}, decode func(io.Reader) error) error {
+	key := sportsdata.RedactURL(u)
+	var cached *sportsdata.CacheEntry
+	if a.cache != nil {
+		if entry, ok := a.cache.Get(key); ok {
+			if entry.Fresh(time.Now()) {
+				return decode(bytes.NewReader(entry.Body))
+			}
+			cached = entry
+		}
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return err
+	}
+	if cached != nil {
+		cached.Revalidate(req)
+	}
+	resp, err := a.retry.Do(ctx, func() (*http.Response, error) {
+		if err := a.limiter.Wait(ctx); err != nil {
 			return nil, err
 		}
-		divisions = append(divisions, division)
+		return a.client.Do(req.WithContext(ctx))
+	})
+	if err != nil {
+		return sportsdata.RedactError(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		if a.log {
+			log.Printf("%s not modified: %+v\n", endpoint, key)
+		}
+		if err := decode(bytes.NewReader(cached.Body)); err != nil {
+			return err
+		}
+		refreshed := *cached
+		refreshed.Refresh(a.cacheTTL(endpoint, v))
+		a.cache.Set(key, &refreshed)
+		return nil
 	}
-	return divisions, nil
+	if resp.StatusCode != http.StatusOK {
+		return sportsdata.NewAPIError(endpoint, resp)
+	}
+	if a.cache == nil {
+		return decode(resp.Body)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if err := decode(bytes.NewReader(body)); err != nil {
+		return err
+	}
+	if ttl := a.cacheTTL(endpoint, v); ttl > 0 {
+		a.cache.Set(key, sportsdata.NewCacheEntry(resp, body, ttl))
+	}
+	return nil
 }
 
-func (a *API) Schedule(year string, scheduleType ScheduleType) (*Schedule, error) {
-	u, err := a.scheduleEndpoint(year, scheduleType)
+// get fetches u and decodes the response into v.
+func (a *API) get(ctx context.Context, endpoint sportsdata.Endpoint, u *url.URL, v interface{}) error {
+	return a.fetch(ctx, endpoint, u, v, func(body io.Reader) error {
+		return a.format.Decode(body, v)
+	})
+}
+
+func (a *API) Division(divisionType DivisionType) (*Division, error) {
+	return a.DivisionContext(context.Background(), divisionType)
+}
+
+func (a *API) DivisionContext(ctx context.Context, divisionType DivisionType) (*Division, error) {
+	u, err := a.divisionEndpoint(divisionType)
 	if err != nil {
 		return nil, err
 	}
-	time.Sleep(1 * time.Second)
-	resp, err := http.Get(u.String())
+	division := new(Division)
+	err = a.get(ctx, sportsdata.EndpointHierarchy, u, division)
 	if err != nil {
 		return nil, err
 	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(fmt.Sprintf("API Status Returned Code %d.\nRequest: %+v\nResponse: %+v\n", resp.StatusCode, resp.Request, resp))
+	return division, nil
+}
+
+// AllDivisions fetches the hierarchy of every division, in the order of
+// DivisionAll. A failed request leaves nil at its index, and the error is a
+// *sportsdata.BatchError.
+func (a *API) AllDivisions() ([]*Division, error) {
+	return a.AllDivisionsContext(context.Background())
+}
+
+func (a *API) AllDivisionsContext(ctx context.Context) ([]*Division, error) {
+	results := a.AllDivisionsResultsContext(ctx)
+	divisions := make([]*Division, len(results))
+	for i, result := range results {
+		divisions[i] = result.Division
 	}
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
+	return divisions, results.Err()
+}
+
+func (a *API) Schedule(year string, scheduleType ScheduleType) (*Schedule, error) {
+	return a.ScheduleContext(context.Background(), year, scheduleType)
+}
+
+func (a *API) ScheduleContext(ctx context.Context, year string, scheduleType ScheduleType) (*Schedule, error) {
+	u, err := a.scheduleEndpoint(year, scheduleType)
 	if err != nil {
 		return nil, err
 	}
 	season := new(Season)
-	err = xml.Unmarshal(body, season)
+	err = a.get(ctx, sportsdata.EndpointSchedule, u, season)
 	if err != nil {
 		return nil, err
 	}
@@ -190,67 +428,277 @@ func (a *API) Schedule(year string, scheduleType ScheduleType) (*Schedule, error
 	return schedule, nil
 }
 
-func (a *API) AllSchedules(years []string) ([]*Schedule, error) {
-	schedules := make([]*Schedule, 0)
-	for _, year := range years {
-		for _, scheduleType := range ScheduleAll {
-			schedule, err := a.Schedule(year, scheduleType)
-			if err != nil {
-				return nil, err
+// EachScheduleGame streams the schedule for year and scheduleType, calling fn
+// with every game and its week as it is decoded rather than holding the whole
+// season in memory. Iteration stops at the first error returned by fn.
+func (a *API) EachScheduleGame(year string, scheduleType ScheduleType, fn func(week string, game *Game) error) error {
+	return a.EachScheduleGameContext(context.Background(), year, scheduleType, fn)
+}
+
+func (a *API) EachScheduleGameContext(ctx context.Context, year string, scheduleType ScheduleType, fn func(week string, game *Game) error) error {
+	u, err := a.scheduleEndpoint(year, scheduleType)
+	if err != nil {
+		return err
+	}
+	return a.fetch(ctx, sportsdata.EndpointSchedule, u, nil, func(body io.Reader) error {
+		if a.format != sportsdata.FormatXML {
+			season := new(Season)
+			if err := a.format.Decode(body, season); err != nil {
+				return err
+			}
+			for _, w := range season.Weeks {
+				for _, g := range w.Games {
+					if err := fn(w.Week, g); err != nil {
+						return err
+					}
+				}
 			}
-			schedules = append(schedules, schedule)
+			return nil
 		}
+		var week string
+		return sportsdata.EachStartElement(body, func(d *xml.Decoder, start xml.StartElement) error {
+			switch start.Name.Local {
+			case "week":
+				week = sportsdata.Attr(start, "week")
+			case "game":
+				game := new(Game)
+				if err := d.DecodeElement(game, &start); err != nil {
+					return err
+				}
+				return fn(week, game)
+			}
+			return nil
+		})
+	})
+}
+
+// AllSchedules fetches the schedule of every type of each of years, ordered
+// by year and then by ScheduleAll. A failed request leaves nil at its
+// index, and the error is a *sportsdata.BatchError.
+func (a *API) AllSchedules(years []string) ([]*Schedule, error) {
+	return a.AllSchedulesContext(context.Background(), years)
+}
+
+func (a *API) AllSchedulesContext(ctx context.Context, years []string) ([]*Schedule, error) {
+	results := a.AllSchedulesResultsContext(ctx, years)
+	schedules := make([]*Schedule, len(results))
+	for i, result := range results {
+		schedules[i] = result.Schedule
 	}
-	return schedules, nil
+	return schedules, results.Err()
 }
 
 func (a *API) Boxscore(year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*Boxscore, error) {
-	u, err := a.boxscoreEndpoint(year, scheduleType, week, awayTeamId, homeTeamId)
+	return a.BoxscoreContext(context.Background(), year, scheduleType, week, awayTeamId, homeTeamId)
+}
+
+func (a *API) BoxscoreContext(ctx context.Context, year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*Boxscore, error) {
+	u, err := a.gameEndpoint(year, scheduleType, week, awayTeamId, homeTeamId, sportsdata.EndpointBoxscore)
 	if err != nil {
 		return nil, err
 	}
-	time.Sleep(1 * time.Second)
-	resp, err := http.Get(u.String())
+	boxscore := new(Boxscore)
+	err = a.get(ctx, sportsdata.EndpointBoxscore, u, boxscore)
 	if err != nil {
 		return nil, err
 	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(fmt.Sprintf("API Status Returned Code %d.\nRequest: %+v\nResponse: %+v\n", resp.StatusCode, resp.Request, resp))
+	boxscore.Year = year
+	boxscore.ScheduleType = scheduleType
+	boxscore.Week = week
+	return boxscore, nil
+}
+
+func (a *API) GameStatistics(year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*GameStatistics, error) {
+	return a.GameStatisticsContext(context.Background(), year, scheduleType, week, awayTeamId, homeTeamId)
+}
+
+func (a *API) GameStatisticsContext(ctx context.Context, year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*GameStatistics, error) {
+	u, err := a.gameEndpoint(year, scheduleType, week, awayTeamId, homeTeamId, sportsdata.EndpointStatistics)
+	if err != nil {
+		return nil, err
 	}
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
+	statistics := new(GameStatistics)
+	err = a.get(ctx, sportsdata.EndpointStatistics, u, statistics)
 	if err != nil {
 		return nil, err
 	}
-	boxscore := new(Boxscore)
-	err = xml.Unmarshal(body, boxscore)
+	statistics.Year = year
+	statistics.ScheduleType = scheduleType
+	statistics.Week = week
+	return statistics, nil
+}
+
+func (a *API) PlayByPlay(year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*PlayByPlay, error) {
+	return a.PlayByPlayContext(context.Background(), year, scheduleType, week, awayTeamId, homeTeamId)
+}
+
+func (a *API) PlayByPlayContext(ctx context.Context, year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*PlayByPlay, error) {
+	u, err := a.gameEndpoint(year, scheduleType, week, awayTeamId, homeTeamId, sportsdata.EndpointPlayByPlay)
 	if err != nil {
 		return nil, err
 	}
-	boxscore.Year = year
-	boxscore.ScheduleType = scheduleType
-	boxscore.Week = week
-	return boxscore, nil
+	pbp := new(PlayByPlay)
+	err = a.get(ctx, sportsdata.EndpointPlayByPlay, u, pbp)
+	if err != nil {
+		return nil, err
+	}
+	pbp.Year = year
+	pbp.ScheduleType = scheduleType
+	pbp.Week = week
+	return pbp, nil
+}
+
+func (a *API) GameRoster(year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*GameRoster, error) {
+	return a.GameRosterContext(context.Background(), year, scheduleType, week, awayTeamId, homeTeamId)
 }
 
+func (a *API) GameRosterContext(ctx context.Context, year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*GameRoster, error) {
+	u, err := a.gameEndpoint(year, scheduleType, week, awayTeamId, homeTeamId, sportsdata.EndpointRoster)
+	if err != nil {
+		return nil, err
+	}
+	roster := new(GameRoster)
+	err = a.get(ctx, sportsdata.EndpointRoster, u, roster)
+	if err != nil {
+		return nil, err
+	}
+	roster.Year = year
+	roster.ScheduleType = scheduleType
+	roster.Week = week
+	for _, team := range roster.Teams {
+		team.setTeamIds()
+	}
+	return roster, nil
+}
+
+// TeamRoster returns the current season roster of the team with teamId, as
+// listed by Division.Teams.
+func (a *API) TeamRoster(teamId string) (*TeamRoster, error) {
+	return a.TeamRosterContext(context.Background(), teamId)
+}
+
+func (a *API) TeamRosterContext(ctx context.Context, teamId string) (*TeamRoster, error) {
+	u, err := a.teamRosterEndpoint(teamId)
+	if err != nil {
+		return nil, err
+	}
+	roster := new(TeamRoster)
+	err = a.get(ctx, sportsdata.EndpointRoster, u, roster)
+	if err != nil {
+		return nil, err
+	}
+	roster.setTeamIds()
+	return roster, nil
+}
+
+// GameSummary returns the current state of a game. It is smaller than the
+// boxscore and suited to polling live games.
+func (a *API) GameSummary(year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*GameSummary, error) {
+	return a.GameSummaryContext(context.Background(), year, scheduleType, week, awayTeamId, homeTeamId)
+}
+
+func (a *API) GameSummaryContext(ctx context.Context, year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*GameSummary, error) {
+	u, err := a.gameEndpoint(year, scheduleType, week, awayTeamId, homeTeamId, sportsdata.EndpointSummary)
+	if err != nil {
+		return nil, err
+	}
+	summary := new(GameSummary)
+	err = a.get(ctx, sportsdata.EndpointSummary, u, summary)
+	if err != nil {
+		return nil, err
+	}
+	summary.Year = year
+	summary.ScheduleType = scheduleType
+	summary.Week = week
+	return summary, nil
+}
+
+// Follow fetches the per-game feed link points to, as listed in Game.Links,
+// and returns it decoded by rel: *GameStatistics for "statistics",
+// *GameSummary for "summary", *PlayByPlay for "pbp", *Boxscore for
+// "boxscore" and *GameRoster for "roster". Other rels return
+// ErrUnsupportedLink.
+func (a *API) Follow(link Link) (interface{}, error) {
+	return a.FollowContext(context.Background(), link)
+}
+
+func (a *API) FollowContext(ctx context.Context, link Link) (interface{}, error) {
+	feed := sportsdata.Endpoint(link.Rel)
+	var v interface{}
+	switch feed {
+	case sportsdata.EndpointStatistics:
+		v = new(GameStatistics)
+	case sportsdata.EndpointSummary:
+		v = new(GameSummary)
+	case sportsdata.EndpointPlayByPlay:
+		v = new(PlayByPlay)
+	case sportsdata.EndpointBoxscore:
+		v = new(Boxscore)
+	case sportsdata.EndpointRoster:
+		v = new(GameRoster)
+	default:
+		return nil, fmt.Errorf("%w: rel %q", ErrUnsupportedLink, link.Rel)
+	}
+	u, err := a.linkEndpoint(link)
+	if err != nil {
+		return nil, err
+	}
+	err = a.get(ctx, feed, u, v)
+	if err != nil {
+		return nil, err
+	}
+	year, scheduleType, week := linkGame(link.Href)
+	switch v := v.(type) {
+	case *GameStatistics:
+		v.Year, v.ScheduleType, v.Week = year, scheduleType, week
+	case *GameSummary:
+		v.Year, v.ScheduleType, v.Week = year, scheduleType, week
+	case *PlayByPlay:
+		v.Year, v.ScheduleType, v.Week = year, scheduleType, week
+	case *Boxscore:
+		v.Year, v.ScheduleType, v.Week = year, scheduleType, week
+	case *GameRoster:
+		v.Year, v.ScheduleType, v.Week = year, scheduleType, week
+		for _, team := range v.Teams {
+			team.setTeamIds()
+		}
+	}
+	return v, nil
+}
+
+// linkGame returns the year, schedule type and week of a per-game link such
+// as /2014/REG/1/SHS/EW/statistics.xml, or empty strings if href does not
+// have that form.
+func linkGame(href string) (string, ScheduleType, string) {
+	parts := strings.Split(strings.Trim(href, "/"), "/")
+	if len(parts) != 6 {
+		return "", "", ""
+	}
+	return parts[0], ScheduleType(strings.ToLower(parts[1])), parts[2]
+}
+
+// ScheduleBoxscores fetches the boxscores of the games in schedule whose ids
+// are listed, in schedule order. Ids of games not in schedule are skipped. A
+// failed request leaves nil at its index, and the error is a
+// *sportsdata.BatchError.
 func (a *API) ScheduleBoxscores(schedule *Schedule, ids []string) ([]*Boxscore, error) {
-	boxscores := make([]*Boxscore, 0)
-	for _, w := range schedule.Season.Weeks {
-		for _, g := range w.Games {
-			for _, id := range ids {
-				if g.Id == id {
-					if a.log {
-						log.Printf("Getting boxscore for %s: %s, %s, %s, %s, %s\n", g.Id, schedule.Year, schedule.ScheduleType, w.Week, g.AwayTeamId, g.HomeTeamId)
-					}
-					boxscore, err := a.Boxscore(schedule.Year, schedule.ScheduleType, w.Week, g.AwayTeamId, g.HomeTeamId)
-					if err != nil {
-						return nil, err
-					}
-					boxscores = append(boxscores, boxscore)
-					break
-				}
+	return a.ScheduleBoxscoresContext(context.Background(), schedule, ids)
+}
+
+func (a *API) ScheduleBoxscoresContext(ctx context.Context, schedule *Schedule, ids []string) ([]*Boxscore, error) {
+	results := a.ScheduleBoxscoresResultsContext(ctx, schedule, ids)
+	scheduled := make(BoxscoreResults, 0)
+	for _, g := range schedule.Games() {
+		for _, result := range results {
+			if result.GameId == g.Id {
+				scheduled = append(scheduled, result)
+				break
 			}
 		}
 	}
-	return boxscores, nil
+	boxscores := make([]*Boxscore, len(scheduled))
+	for i, result := range scheduled {
+		boxscores[i] = result.Boxscore
+	}
+	return boxscores, scheduled.Err()
 }
@@ -0,0 +1,209 @@
+package ncaafb
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/tassl-app/sportsdata"
+	"github.com/tassl-app/sportsdata/sportsdatatest"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestAPIHTTPClient(t *testing.T) {
+	var requested string
+	client := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			requested = req.URL.Path
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(divisionConferenceData)),
+				Request:    req,
+			}, nil
+		}),
+	}
+	api := NewAPIWithOptions("key", WithHTTPClient(client))
+	division, err := api.Division(DivisionFBS)
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	expectedPath := "/ncaafb-t1/teams/FBS/hierarchy.xml"
+	if requested != expectedPath {
+		t.Errorf("Expected request path %s, found %s\n", expectedPath, requested)
+		return
+	}
+	expectedDivisionId := "FBS"
+	if division.Id != expectedDivisionId {
+		t.Errorf("Expected division id %s, found %s\n", expectedDivisionId, division.Id)
+		return
+	}
+}
+
+func TestAPIContextCanceled(t *testing.T) {
+	client := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			t.Errorf("Expected no request, found %s\n", req.URL.Path)
+			return nil, context.Canceled
+		}),
+	}
+	api := NewAPIWithOptions("key", WithHTTPClient(client))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := api.ScheduleContext(ctx, "2014", ScheduleRegular)
+	if err != context.Canceled {
+		t.Errorf("Expected error %v, found %v\n", context.Canceled, err)
+		return
+	}
+}
+
+func TestAPIBaseURL(t *testing.T) {
+	var requested *url.URL
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL
+		if r.URL.Path != "/ncaafb-t2/2014/reg/4/AUB/KST/boxscore.xml" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(boxscoreData))
+	}))
+	defer server.Close()
+	api := NewAPIWithOptions("secret", WithBaseURL(server.URL+"/"), WithVersion("2"), WithRateLimiter(nil))
+	boxscore, err := api.Boxscore("2014", ScheduleRegular, "4", "AUB", "KST")
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	if requested.Query().Get("api_key") != "secret" {
+		t.Errorf("Expected api key %s, found %s\n", "secret", requested.Query().Get("api_key"))
+		return
+	}
+	expectedBoxscoreId := "e5896e5f-3779-4726-bee9-512d9d0746b2"
+	if boxscore.Id != expectedBoxscoreId {
+		t.Errorf("Expected boxscore id %s, found %s\n", expectedBoxscoreId, boxscore.Id)
+		return
+	}
+	_, err = api.Boxscore("2014", ScheduleRegular, "5", "AUB", "KST")
+	if !errors.Is(err, sportsdata.ErrNotFound) {
+		t.Errorf("Expected error %v, found %v\n", sportsdata.ErrNotFound, err)
+		return
+	}
+	if strings.Contains(err.Error(), "secret") {
+		t.Errorf("Expected api key to be redacted, found %s\n", err.Error())
+		return
+	}
+}
+
+func TestAPIEachScheduleGame(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(seasonData))
+	}))
+	defer server.Close()
+	api := NewAPIWithOptions("key", WithBaseURL(server.URL), WithRateLimiter(nil))
+	weeks := make([]string, 0)
+	err := api.EachScheduleGame("2014", ScheduleRegular, func(week string, game *Game) error {
+		weeks = append(weeks, week)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	if strings.Join(weeks, ",") != "1,1,2" {
+		t.Errorf("Expected game weeks %s, found %s\n", "1,1,2", strings.Join(weeks, ","))
+		return
+	}
+	stop := errors.New("stop")
+	games := 0
+	err = api.EachScheduleGame("2014", ScheduleRegular, func(week string, game *Game) error {
+		games++
+		return stop
+	})
+	if err != stop || games != 1 {
+		t.Errorf("Expected to stop after %d game, found %d games and error %v\n", 1, games, err)
+		return
+	}
+}
+
+func TestAPIGameStatistics(t *testing.T) {
+	s := sportsdatatest.NewServer()
+	defer s.Close()
+	s.Handle(sportsdatatest.FootballGamePath("2014", string(ScheduleRegular), "4", "AUB", "KST", "statistics"), []byte(statisticsData))
+	api := NewAPIWithOptions("key", WithBaseURL(s.URL), WithRateLimiter(nil))
+	statistics, err := api.GameStatistics("2014", ScheduleRegular, "4", "AUB", "KST")
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	if statistics.Week != "4" || len(statistics.Teams) != 2 {
+		t.Errorf("Expected week %s with %d teams, found %+v\n", "4", 2, statistics)
+		return
+	}
+}
+
+func TestAPITeamRoster(t *testing.T) {
+	s := sportsdatatest.NewServer()
+	defer s.Close()
+	s.Handle(sportsdatatest.FootballTeamRosterPath("KST"), []byte(`<team id="KST" name="Wildcats" market="Kansas State"><player id="1" name_full="Jake Waters" jersey="15" position="QB"/></team>`))
+	api := NewAPIWithOptions("key", WithBaseURL(s.URL), WithRateLimiter(nil))
+	roster, err := api.TeamRoster("KST")
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	if len(roster.Players) != 1 || roster.Players[0].TeamId != "KST" {
+		t.Errorf("Expected %d player on team %s, found %+v\n", 1, "KST", roster.Players)
+		return
+	}
+}
+
+func TestAPIFollow(t *testing.T) {
+	s := sportsdatatest.NewServer()
+	defer s.Close()
+	s.APIKey = "key"
+	s.Handle(sportsdatatest.FootballGamePath("2014", "REG", "4", "AUB", "KST", "summary"), []byte(gameSummaryData))
+	api := NewAPIWithOptions("key", WithBaseURL(s.URL), WithRateLimiter(nil))
+	links := Links{Links: []Link{
+		{Rel: "boxscore", Href: "/2014/REG/4/AUB/KST/boxscore.xml"},
+		{Rel: "summary", Href: "/2014/REG/4/AUB/KST/summary.xml"},
+	}}
+	link, ok := links.Rel("summary")
+	if !ok {
+		t.Errorf("Expected link %s, found %+v\n", "summary", links)
+		return
+	}
+	v, err := api.Follow(link)
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	summary, ok := v.(*GameSummary)
+	if !ok {
+		t.Errorf("Expected *GameSummary, found %T\n", v)
+		return
+	}
+	if summary.ScheduleType != ScheduleRegular || summary.Week != "4" || summary.Id == "" {
+		t.Errorf("Expected week %s of %s, found %+v\n", "4", ScheduleRegular, summary)
+		return
+	}
+	_, err = api.Follow(Link{Rel: "standings", Href: "/2014/REG/standings.xml"})
+	if !errors.Is(err, ErrUnsupportedLink) {
+		t.Errorf("Expected error %v, found %v\n", ErrUnsupportedLink, err)
+		return
+	}
+	_, err = api.Follow(Link{Rel: "boxscore", Href: "http://example.com/boxscore.xml"})
+	if !errors.Is(err, ErrUnsupportedLink) {
+		t.Errorf("Expected error %v, found %v\n", ErrUnsupportedLink, err)
+		return
+	}
+}
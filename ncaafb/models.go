@@ -1,37 +1,40 @@
 package ncaafb
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"github.com/tassl-app/sportsdata"
+	"strings"
 	"time"
 )
 
 type Team struct {
-	Id            string `xml:"id,attr"`
-	SubdivisionId string `xml:"-"`
-	ConferenceId  string `xml:"-"`
-	Name          string `xml:"name,attr"`
-	Market        string `xml:"market,attr"`
-	Coverage      string `xml:"coverage,attr"`
+	Id            string `xml:"id,attr" json:"id"`
+	SubdivisionId string `xml:"-" json:"-"`
+	ConferenceId  string `xml:"-" json:"-"`
+	Name          string `xml:"name,attr" json:"name"`
+	Market        string `xml:"market,attr" json:"market"`
+	Coverage      string `xml:"coverage,attr" json:"coverage"`
 }
 
 type Subdivision struct {
-	Id    string  `xml:"id,attr"`
-	Name  string  `xml:"name,attr"`
-	Teams []*Team `xml:"team"`
+	Id    string  `xml:"id,attr" json:"id"`
+	Name  string  `xml:"name,attr" json:"name"`
+	Teams []*Team `xml:"team" json:"teams"`
 }
 
 type Conference struct {
-	Id           string         `xml:"id,attr"`
-	Name         string         `xml:"name,attr"`
-	Subdivisions []*Subdivision `xml:"subdivision"`
-	Teams        []*Team        `xml:"team"`
+	Id           string         `xml:"id,attr" json:"id"`
+	Name         string         `xml:"name,attr" json:"name"`
+	Subdivisions []*Subdivision `xml:"subdivision" json:"subdivisions"`
+	Teams        []*Team        `xml:"team" json:"teams"`
 }
 
 type Division struct {
-	XMLNS       string        `xml:"xmlns,attr"`
-	Id          string        `xml:"id,attr"`
-	Name        string        `xml:"name,attr"`
-	Conferences []*Conference `xml:"conference"`
+	XMLNS       string        `xml:"xmlns,attr" json:"-"`
+	Id          string        `xml:"id,attr" json:"id"`
+	Name        string        `xml:"name,attr" json:"name"`
+	Conferences []*Conference `xml:"conference" json:"conferences"`
 }
 
 func (d *Division) Teams() []*Team {
@@ -52,59 +55,190 @@ func (d *Division) Teams() []*Team {
 }
 
 type Link struct {
-	Rel      string `xml:"rel,attr"`
-	Href     string `xml:"href,attr"`
-	LinkType string `xml:"link,attr"`
+	Rel      string `xml:"rel,attr" json:"rel"`
+	Href     string `xml:"href,attr" json:"href"`
+	LinkType string `xml:"link,attr" json:"type"`
 }
 
 type Links struct {
-	Links []Link `xml:"link"`
+	Links []Link `xml:"link" json:"links"`
+}
+
+// JSON feeds list links directly rather than inside a links element.
+func (l Links) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.Links)
+}
+
+func (l *Links) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &l.Links)
+}
+
+// Rel returns the first link with rel, such as "boxscore".
+func (l Links) Rel(rel string) (Link, bool) {
+	for _, link := range l.Links {
+		if link.Rel == rel {
+			return link, true
+		}
+	}
+	return Link{}, false
 }
 
 type Broadcast struct {
-	Network   string `xml:"network,attr"`
-	Satellite string `xml:"satellite,attr"`
-	Internet  string `xml:"internet,attr"`
-	Cable     string `xml:"cable,attr"`
+	Network   string `xml:"network,attr" json:"network"`
+	Satellite string `xml:"satellite,attr" json:"satellite"`
+	Internet  string `xml:"internet,attr" json:"internet"`
+	Cable     string `xml:"cable,attr" json:"cable"`
 }
 
+// Wind is decoded tolerantly: a blank or invalid speed is zero and an
+// unrecognized direction is empty.
 type Wind struct {
-	Speed     string `xml:"speed,attr"`
-	Direction string `xml:"direction,attr"`
+	// Speed is in miles per hour.
+	Speed     float64
+	Direction WindDirection
+}
+
+func (w *Wind) stats() []sportsdata.Stat {
+	return []sportsdata.Stat{
+		sportsdata.FloatStat("speed", &w.Speed),
+		sportsdata.TextStat("direction", (*string)(&w.Direction)),
+	}
+}
+
+func (w *Wind) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if err := sportsdata.UnmarshalStatsXML(d, start, w.stats()); err != nil {
+		return err
+	}
+	w.Direction = ParseWindDirection(string(w.Direction))
+	return nil
+}
+
+func (w *Wind) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXML(e, start, w.stats())
+}
+
+func (w *Wind) UnmarshalJSON(data []byte) error {
+	if err := sportsdata.UnmarshalStatsJSON(data, w.stats()); err != nil {
+		return err
+	}
+	w.Direction = ParseWindDirection(string(w.Direction))
+	return nil
+}
+
+func (w *Wind) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSON(w.stats())
+}
+
+// WindDirection is one of the 16 compass points, such as "N" or "NNE", or
+// empty if the feed gives no direction or one that is not recognized.
+type WindDirection string
+
+var windDirections = []WindDirection{"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE", "S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW"}
+
+var windDirectionReplacer = strings.NewReplacer("NORTH", "N", "SOUTH", "S", "EAST", "E", "WEST", "W", " ", "", "-", "", "/", "")
+
+// ParseWindDirection normalizes the directions used by the feeds, such as
+// "NE", "ne", "North East" and "Northeast", to a compass point.
+func ParseWindDirection(s string) WindDirection {
+	d := WindDirection(windDirectionReplacer.Replace(strings.ToUpper(strings.TrimSpace(s))))
+	for _, direction := range windDirections {
+		if d == direction {
+			return d
+		}
+	}
+	return ""
+}
+
+// Degrees returns the direction the wind blows from, clockwise from north.
+func (d WindDirection) Degrees() (float64, bool) {
+	for i, direction := range windDirections {
+		if d == direction {
+			return float64(i) * 22.5, true
+		}
+	}
+	return 0, false
+}
+
+func (d *WindDirection) UnmarshalXMLAttr(attr xml.Attr) error {
+	*d = ParseWindDirection(attr.Value)
+	return nil
 }
 
+func (d *WindDirection) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*d = ParseWindDirection(s)
+	return nil
+}
+
+// Weather is the forecast for a game. Temperature and Humidity are nil when
+// the feed leaves them blank, as it does for games in domes.
 type Weather struct {
-	Temperature string `xml:"temperature,attr"`
-	Condition   string `xml:"condition,attr"`
-	Humidity    string `xml:"humidty,attr"`
-	Wind        Wind   `xml:"wind"`
+	// Temperature is in degrees Fahrenheit.
+	Temperature *float64
+	Condition   string
+	// Humidity is relative humidity in percent.
+	Humidity *float64
+	Wind     Wind
+}
+
+// weatherWind holds the child elements of Weather.
+type weatherWind struct {
+	Wind *Wind `xml:"wind" json:"wind"`
+}
+
+func (w *Weather) stats() []sportsdata.Stat {
+	return []sportsdata.Stat{
+		sportsdata.NullFloatStat("temperature", &w.Temperature),
+		sportsdata.TextStat("condition", &w.Condition),
+		sportsdata.NullFloatStat("humidity", &w.Humidity),
+	}
+}
+
+func (w *Weather) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return sportsdata.UnmarshalStatsXMLElement(d, start, w.stats(), &weatherWind{Wind: &w.Wind})
+}
+
+func (w *Weather) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXMLElement(e, start, w.stats(), &weatherWind{Wind: &w.Wind})
+}
+
+func (w *Weather) UnmarshalJSON(data []byte) error {
+	return sportsdata.UnmarshalStatsJSONObject(data, w.stats(), &weatherWind{Wind: &w.Wind})
+}
+
+func (w *Weather) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSONObject(w.stats(), &weatherWind{Wind: &w.Wind})
 }
 
 type Venue struct {
-	Id        string `xml:"id,attr"`
-	Name      string `xml:"name,attr"`
-	Address   string `xml:"address,attr"`
-	City      string `xml:"city,attr"`
-	State     string `xml:"state,attr"`
-	Zip       string `xml:"zip,attr"`
-	Country   string `xml:"country,attr"`
-	Capacity  string `xml:"capacity,attr"`
-	Surface   string `xml:"surface,attr"`
-	VenueType string `xml:"type,attr"`
+	Id        string `xml:"id,attr" json:"id"`
+	Name      string `xml:"name,attr" json:"name"`
+	Address   string `xml:"address,attr" json:"address"`
+	City      string `xml:"city,attr" json:"city"`
+	State     string `xml:"state,attr" json:"state"`
+	Zip       string `xml:"zip,attr" json:"zip"`
+	Country   string `xml:"country,attr" json:"country"`
+	Capacity  string `xml:"capacity,attr" json:"capacity"`
+	Surface   string `xml:"surface,attr" json:"surface"`
+	VenueType string `xml:"type,attr" json:"type"`
 }
 
 type Game struct {
-	Id           string            `xml:"id,attr"`
-	Scheduled    string            `xml:"scheduled,attr"`
-	Coverage     string            `xml:"coverage,attr"`
-	HomeRotation string            `xml:"home_rotation,attr"`
-	AwayRotation string            `xml:"away_rotation,attr"`
-	HomeTeamId   string            `xml:"home,attr"`
-	AwayTeamId   string            `xml:"away,attr"`
-	Status       string            `xml:"status,attr"`
-	Venue        *sportsdata.Venue `xml:"venue"`
-	Broadcast    *Broadcast        `xml:"broadcast"`
-	Links        Links             `xml:"links"`
+	Id           string            `xml:"id,attr" json:"id"`
+	Scheduled    string            `xml:"scheduled,attr" json:"scheduled"`
+	Coverage     string            `xml:"coverage,attr" json:"coverage"`
+	HomeRotation string            `xml:"home_rotation,attr" json:"home_rotation"`
+	AwayRotation string            `xml:"away_rotation,attr" json:"away_rotation"`
+	HomeTeamId   string            `xml:"home,attr" json:"home"`
+	AwayTeamId   string            `xml:"away,attr" json:"away"`
+	Status       string            `xml:"status,attr" json:"status"`
+	Venue        *sportsdata.Venue `xml:"venue" json:"venue"`
+	Broadcast    *Broadcast        `xml:"broadcast" json:"broadcast"`
+	Weather      *Weather          `xml:"weather" json:"weather"`
+	Links        Links             `xml:"links" json:"links"`
 }
 
 func (g *Game) FormattedScheduled() (time.Time, error) {
@@ -116,15 +250,43 @@ func (g *Game) ParseScheduled(t time.Time) string {
 }
 
 type Week struct {
-	Week  string  `xml:"week,attr"`
-	Games []*Game `xml:"game"`
+	Week  string  `xml:"week,attr" json:"number"`
+	Games []*Game `xml:"game" json:"games"`
+}
+
+// JSON feeds give the week as a number.
+func (w *Week) UnmarshalJSON(data []byte) error {
+	type week Week
+	v := struct {
+		*week
+		Week json.Number `json:"number"`
+	}{week: (*week)(w)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	w.Week = v.Week.String()
+	return nil
 }
 
 type Season struct {
-	XMLNS      string  `xml:"xmlns,attr"`
-	Season     string  `xml:"season,attr"`
-	SeasonType string  `xml:"type,attr"`
-	Weeks      []*Week `xml:"week"`
+	XMLNS      string  `xml:"xmlns,attr" json:"-"`
+	Season     string  `xml:"season,attr" json:"season"`
+	SeasonType string  `xml:"type,attr" json:"type"`
+	Weeks      []*Week `xml:"week" json:"weeks"`
+}
+
+// JSON feeds give the season as a number.
+func (s *Season) UnmarshalJSON(data []byte) error {
+	type season Season
+	v := struct {
+		*season
+		Season json.Number `json:"season"`
+	}{season: (*season)(s)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	s.Season = v.Season.String()
+	return nil
 }
 
 func (s *Season) Games() []*Game {
@@ -179,20 +341,25 @@ func (s *Schedule) FilterGames(l []*Game) []*Game {
 }
 
 type Boxscore struct {
-	Year          string                 `xml:"-"`
-	ScheduleType  ScheduleType           `xml:"-"`
-	Week          string                 `xml:"-"`
-	XMLNS         string                 `xml:"xmlns,attr"`
-	Id            string                 `xml:"id,attr"`
-	Scheduled     string                 `xml:"scheduled,attr"`
-	HomeTeamId    string                 `xml:"home,attr"`
-	AwayTeamId    string                 `xml:"away,attr"`
-	Status        string                 `xml:"status,attr"`
-	Quarter       string                 `xml:"quarter,attr"`
-	Clock         string                 `xml:"clock,attr"`
-	Completed     string                 `xml:"completed,attr"`
-	Teams         []*BoxscoreTeam        `xml:"team"`
-	ScoringDrives *BoxscoreScoringDrives `xml:"scoring_drives"`
+	Year          string                 `xml:"-" json:"-"`
+	ScheduleType  ScheduleType           `xml:"-" json:"-"`
+	Week          string                 `xml:"-" json:"-"`
+	XMLNS         string                 `xml:"xmlns,attr" json:"-"`
+	Id            string                 `xml:"id,attr" json:"id"`
+	Scheduled     string                 `xml:"scheduled,attr" json:"scheduled"`
+	HomeTeamId    string                 `xml:"home,attr" json:"home"`
+	AwayTeamId    string                 `xml:"away,attr" json:"away"`
+	Status        string                 `xml:"status,attr" json:"status"`
+	Quarter       string                 `xml:"quarter,attr" json:"quarter"`
+	Clock         string                 `xml:"clock,attr" json:"clock"`
+	Completed     string                 `xml:"completed,attr" json:"completed"`
+	Teams         []*BoxscoreTeam        `xml:"team" json:"teams"`
+	ScoringDrives *BoxscoreScoringDrives `xml:"scoring_drives" json:"scoring_drives"`
+}
+
+// Closed reports whether the game is final and its statistics verified.
+func (b *Boxscore) Closed() bool {
+	return b.Status == "closed"
 }
 
 func (b *Boxscore) FormattedScheduled() (time.Time, error) {
@@ -238,12 +405,12 @@ func (b *Boxscore) AwayTeamScore() (int64, error) {
 }
 
 type BoxscoreTeam struct {
-	Id                  string               `xml:"id,attr"`
-	Name                string               `xml:"name,attr"`
-	Market              string               `xml:"market,attr"`
-	RemainingChallenges int64                `xml:"remaining_challenges,attr"`
-	RemainingTimeouts   int64                `xml:"remaining_timeouts,attr"`
-	Scoring             *BoxscoreTeamScoring `xml:"scoring"`
+	Id                  string               `xml:"id,attr" json:"id"`
+	Name                string               `xml:"name,attr" json:"name"`
+	Market              string               `xml:"market,attr" json:"market"`
+	RemainingChallenges int64                `xml:"remaining_challenges,attr" json:"remaining_challenges"`
+	RemainingTimeouts   int64                `xml:"remaining_timeouts,attr" json:"remaining_timeouts"`
+	Scoring             *BoxscoreTeamScoring `xml:"scoring" json:"scoring"`
 }
 
 func (t *BoxscoreTeam) Points() (int64, error) {
@@ -254,48 +421,1077 @@ func (t *BoxscoreTeam) Points() (int64, error) {
 }
 
 type BoxscoreTeamScoring struct {
-	Points  int64                         `xml:"points,attr"`
-	Quarter []*BoxscoreTeamScoringQuarter `xml:"quarter"`
+	Points  int64                         `xml:"points,attr" json:"points"`
+	Quarter []*BoxscoreTeamScoringQuarter `xml:"quarter" json:"quarters"`
 }
 
 type BoxscoreTeamScoringQuarter struct {
-	Number int64 `xml:"number,attr"`
-	Points int64 `xml:"points,attr"`
+	Number int64 `xml:"number,attr" json:"number"`
+	Points int64 `xml:"points,attr" json:"points"`
 }
 
 type BoxscoreScoringDrives struct {
-	Drives []*BoxscoreScoringDrive `xml:"drive"`
+	Drives []*BoxscoreScoringDrive `xml:"drive" json:"drives"`
 }
 
 type BoxscoreScoringDrive struct {
-	Sequence string                       `xml:"sequence,attr"`
-	Clock    string                       `xml:"clock,attr"`
-	Quarter  string                       `xml:"quarter,attr"`
-	Team     string                       `xml:"team,attr"`
-	Scores   []*BoxscoreScoringDriveScore `xml:"score"`
+	Sequence string                       `xml:"sequence,attr" json:"sequence"`
+	Clock    string                       `xml:"clock,attr" json:"clock"`
+	Quarter  string                       `xml:"quarter,attr" json:"quarter"`
+	Team     string                       `xml:"team,attr" json:"team"`
+	Scores   []*BoxscoreScoringDriveScore `xml:"score" json:"scores"`
 }
 
 type BoxscoreSummary struct {
 	Data string `xml:",chardata"`
 }
 
+func (s BoxscoreSummary) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Data)
+}
+
+func (s *BoxscoreSummary) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &s.Data)
+}
+
 type BoxscoreScoringDriveScore struct {
-	Id        string                         `xml:"id,attr"`
-	Type      string                         `xml:"type,attr"`
-	Clock     string                         `xml:"clock,attr"`
-	Quarter   string                         `xml:"quarter,attr"`
-	Points    int64                          `xml:"points,attr"`
-	Team      string                         `xml:"team,attr"`
-	GameScore *BoxscoreScoringDriveGameScore `xml:"game-score"`
-	Summary   *BoxscoreSummary               `xml:"summary"`
-	Links     *Links                         `xml:"links"`
+	Id        string                         `xml:"id,attr" json:"id"`
+	Type      string                         `xml:"type,attr" json:"type"`
+	Clock     string                         `xml:"clock,attr" json:"clock"`
+	Quarter   string                         `xml:"quarter,attr" json:"quarter"`
+	Points    int64                          `xml:"points,attr" json:"points"`
+	Team      string                         `xml:"team,attr" json:"team"`
+	GameScore *BoxscoreScoringDriveGameScore `xml:"game-score" json:"game_score"`
+	Summary   *BoxscoreSummary               `xml:"summary" json:"summary"`
+	Links     *Links                         `xml:"links" json:"links"`
 }
 
 type BoxscoreScoringDriveGameScore struct {
-	Teams []*BoxscoreScoringDriveGameScoreTeam `xml:"team"`
+	Teams []*BoxscoreScoringDriveGameScoreTeam `xml:"team" json:"teams"`
 }
 
 type BoxscoreScoringDriveGameScoreTeam struct {
-	Id     string `xml:"id,attr"`
-	Points int64  `xml:"points,attr"`
+	Id     string `xml:"id,attr" json:"id"`
+	Points int64  `xml:"points,attr" json:"points"`
+}
+
+// GameStatistics is the statistics feed of a game, with team totals and
+// player lines for every statistics category.
+type GameStatistics struct {
+	Year         string            `xml:"-" json:"-"`
+	ScheduleType ScheduleType      `xml:"-" json:"-"`
+	Week         string            `xml:"-" json:"-"`
+	XMLNS        string            `xml:"xmlns,attr" json:"-"`
+	Id           string            `xml:"id,attr" json:"id"`
+	Scheduled    string            `xml:"scheduled,attr" json:"scheduled"`
+	HomeTeamId   string            `xml:"home,attr" json:"home"`
+	AwayTeamId   string            `xml:"away,attr" json:"away"`
+	Status       string            `xml:"status,attr" json:"status"`
+	Teams        []*TeamStatistics `xml:"team" json:"teams"`
+}
+
+// Closed reports whether the game is final and its statistics verified.
+func (s *GameStatistics) Closed() bool {
+	return s.Status == "closed"
+}
+
+func (s *GameStatistics) HomeTeam() *TeamStatistics {
+	for _, t := range s.Teams {
+		if t.Id == s.HomeTeamId {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s *GameStatistics) AwayTeam() *TeamStatistics {
+	for _, t := range s.Teams {
+		if t.Id == s.AwayTeamId {
+			return t
+		}
+	}
+	return nil
+}
+
+// TeamStatistics holds the totals and player lines of a team. Blank or
+// malformed statistics, such as the percentage of a kicker with no
+// attempts, decode as zero.
+type TeamStatistics struct {
+	Id                   string               `xml:"id,attr" json:"id"`
+	Name                 string               `xml:"name,attr" json:"name"`
+	Market               string               `xml:"market,attr" json:"market"`
+	FirstDowns           *FirstDownStatistics `xml:"first_downs" json:"first_downs"`
+	ThirdDownEfficiency  *DownEfficiency      `xml:"third_down_efficiency" json:"third_down_efficiency"`
+	FourthDownEfficiency *DownEfficiency      `xml:"fourth_down_efficiency" json:"fourth_down_efficiency"`
+	Rushing              *RushingStatistics   `xml:"rushing" json:"rushing"`
+	Passing              *PassingStatistics   `xml:"passing" json:"passing"`
+	Receiving            *ReceivingStatistics `xml:"receiving" json:"receiving"`
+	Defense              *DefenseStatistics   `xml:"defense" json:"defense"`
+	Kicking              *KickingStatistics   `xml:"kicking" json:"kicking"`
+	Punting              *PuntingStatistics   `xml:"punting" json:"punting"`
+	PuntReturns          *ReturnStatistics    `xml:"punt_return" json:"punt_return"`
+	KickReturns          *ReturnStatistics    `xml:"kick_return" json:"kick_return"`
+	Penalties            *PenaltyStatistics   `xml:"penalty" json:"penalty"`
+}
+
+type FirstDownStatistics struct {
+	Total   int64
+	Rushing int64
+	Passing int64
+	Penalty int64
+}
+
+func (s *FirstDownStatistics) stats() []sportsdata.Stat {
+	return []sportsdata.Stat{
+		sportsdata.IntStat("num", &s.Total),
+		sportsdata.IntStat("rush", &s.Rushing),
+		sportsdata.IntStat("pass", &s.Passing),
+		sportsdata.IntStat("penalty", &s.Penalty),
+	}
+}
+
+func (s *FirstDownStatistics) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return sportsdata.UnmarshalStatsXML(d, start, s.stats())
+}
+
+func (s *FirstDownStatistics) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXML(e, start, s.stats())
+}
+
+func (s *FirstDownStatistics) UnmarshalJSON(data []byte) error {
+	return sportsdata.UnmarshalStatsJSON(data, s.stats())
+}
+
+func (s *FirstDownStatistics) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSON(s.stats())
+}
+
+type DownEfficiency struct {
+	Attempts    int64
+	Conversions int64
+	Percent     float64
+}
+
+func (s *DownEfficiency) stats() []sportsdata.Stat {
+	return []sportsdata.Stat{
+		sportsdata.IntStat("att", &s.Attempts),
+		sportsdata.IntStat("conv", &s.Conversions),
+		sportsdata.FloatStat("pct", &s.Percent),
+	}
+}
+
+func (s *DownEfficiency) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return sportsdata.UnmarshalStatsXML(d, start, s.stats())
+}
+
+func (s *DownEfficiency) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXML(e, start, s.stats())
+}
+
+func (s *DownEfficiency) UnmarshalJSON(data []byte) error {
+	return sportsdata.UnmarshalStatsJSON(data, s.stats())
+}
+
+func (s *DownEfficiency) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSON(s.stats())
+}
+
+// StatisticsPlayer identifies the player of a statistics line.
+type StatisticsPlayer struct {
+	Id       string
+	Name     string
+	Jersey   string
+	Position string
+}
+
+func (p *StatisticsPlayer) stats() []sportsdata.Stat {
+	return []sportsdata.Stat{
+		sportsdata.TextStat("id", &p.Id),
+		sportsdata.TextStat("name", &p.Name),
+		sportsdata.TextStat("jersey", &p.Jersey),
+		sportsdata.TextStat("position", &p.Position),
+	}
+}
+
+type Rushing struct {
+	Attempts   int64
+	Yards      int64
+	Average    float64
+	Long       int64
+	Touchdowns int64
+}
+
+func (s *Rushing) stats() []sportsdata.Stat {
+	return []sportsdata.Stat{
+		sportsdata.IntStat("att", &s.Attempts),
+		sportsdata.IntStat("yds", &s.Yards),
+		sportsdata.FloatStat("avg", &s.Average),
+		sportsdata.IntStat("lg", &s.Long),
+		sportsdata.IntStat("td", &s.Touchdowns),
+	}
+}
+
+type PlayerRushing struct {
+	StatisticsPlayer
+	Rushing
+}
+
+func (p *PlayerRushing) stats() []sportsdata.Stat {
+	return append(p.StatisticsPlayer.stats(), p.Rushing.stats()...)
+}
+
+func (p *PlayerRushing) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return sportsdata.UnmarshalStatsXML(d, start, p.stats())
+}
+
+func (p *PlayerRushing) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXML(e, start, p.stats())
+}
+
+func (p *PlayerRushing) UnmarshalJSON(data []byte) error {
+	return sportsdata.UnmarshalStatsJSON(data, p.stats())
+}
+
+func (p *PlayerRushing) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSON(p.stats())
+}
+
+type RushingStatistics struct {
+	Rushing
+	Players []*PlayerRushing
+}
+
+type rushingPlayers struct {
+	Players []*PlayerRushing `xml:"player" json:"players"`
+}
+
+func (s *RushingStatistics) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v := new(rushingPlayers)
+	if err := sportsdata.UnmarshalStatsXMLElement(d, start, s.Rushing.stats(), v); err != nil {
+		return err
+	}
+	s.Players = v.Players
+	return nil
+}
+
+func (s *RushingStatistics) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXMLElement(e, start, s.Rushing.stats(), &rushingPlayers{Players: s.Players})
+}
+
+func (s *RushingStatistics) UnmarshalJSON(data []byte) error {
+	v := new(rushingPlayers)
+	if err := sportsdata.UnmarshalStatsJSONObject(data, s.Rushing.stats(), v); err != nil {
+		return err
+	}
+	s.Players = v.Players
+	return nil
+}
+
+func (s *RushingStatistics) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSONObject(s.Rushing.stats(), &rushingPlayers{Players: s.Players})
+}
+
+type Passing struct {
+	Attempts      int64
+	Completions   int64
+	Percent       float64
+	Yards         int64
+	Average       float64
+	Touchdowns    int64
+	Interceptions int64
+	Sacks         int64
+	SackYards     int64
+	Long          int64
+	Rating        float64
+}
+
+func (s *Passing) stats() []sportsdata.Stat {
+	return []sportsdata.Stat{
+		sportsdata.IntStat("att", &s.Attempts),
+		sportsdata.IntStat("cmp", &s.Completions),
+		sportsdata.FloatStat("pct", &s.Percent),
+		sportsdata.IntStat("yds", &s.Yards),
+		sportsdata.FloatStat("avg", &s.Average),
+		sportsdata.IntStat("td", &s.Touchdowns),
+		sportsdata.IntStat("int", &s.Interceptions),
+		sportsdata.IntStat("sk", &s.Sacks),
+		sportsdata.IntStat("sk_yds", &s.SackYards),
+		sportsdata.IntStat("lg", &s.Long),
+		sportsdata.FloatStat("rating", &s.Rating),
+	}
+}
+
+type PlayerPassing struct {
+	StatisticsPlayer
+	Passing
+}
+
+func (p *PlayerPassing) stats() []sportsdata.Stat {
+	return append(p.StatisticsPlayer.stats(), p.Passing.stats()...)
+}
+
+func (p *PlayerPassing) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return sportsdata.UnmarshalStatsXML(d, start, p.stats())
+}
+
+func (p *PlayerPassing) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXML(e, start, p.stats())
+}
+
+func (p *PlayerPassing) UnmarshalJSON(data []byte) error {
+	return sportsdata.UnmarshalStatsJSON(data, p.stats())
+}
+
+func (p *PlayerPassing) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSON(p.stats())
+}
+
+type PassingStatistics struct {
+	Passing
+	Players []*PlayerPassing
+}
+
+type passingPlayers struct {
+	Players []*PlayerPassing `xml:"player" json:"players"`
+}
+
+func (s *PassingStatistics) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v := new(passingPlayers)
+	if err := sportsdata.UnmarshalStatsXMLElement(d, start, s.Passing.stats(), v); err != nil {
+		return err
+	}
+	s.Players = v.Players
+	return nil
+}
+
+func (s *PassingStatistics) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXMLElement(e, start, s.Passing.stats(), &passingPlayers{Players: s.Players})
+}
+
+func (s *PassingStatistics) UnmarshalJSON(data []byte) error {
+	v := new(passingPlayers)
+	if err := sportsdata.UnmarshalStatsJSONObject(data, s.Passing.stats(), v); err != nil {
+		return err
+	}
+	s.Players = v.Players
+	return nil
+}
+
+func (s *PassingStatistics) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSONObject(s.Passing.stats(), &passingPlayers{Players: s.Players})
+}
+
+type Receiving struct {
+	Targets         int64
+	Receptions      int64
+	Yards           int64
+	Average         float64
+	Long            int64
+	Touchdowns      int64
+	YardsAfterCatch int64
+}
+
+func (s *Receiving) stats() []sportsdata.Stat {
+	return []sportsdata.Stat{
+		sportsdata.IntStat("tar", &s.Targets),
+		sportsdata.IntStat("rec", &s.Receptions),
+		sportsdata.IntStat("yds", &s.Yards),
+		sportsdata.FloatStat("avg", &s.Average),
+		sportsdata.IntStat("lg", &s.Long),
+		sportsdata.IntStat("td", &s.Touchdowns),
+		sportsdata.IntStat("yac", &s.YardsAfterCatch),
+	}
+}
+
+type PlayerReceiving struct {
+	StatisticsPlayer
+	Receiving
+}
+
+func (p *PlayerReceiving) stats() []sportsdata.Stat {
+	return append(p.StatisticsPlayer.stats(), p.Receiving.stats()...)
+}
+
+func (p *PlayerReceiving) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return sportsdata.UnmarshalStatsXML(d, start, p.stats())
+}
+
+func (p *PlayerReceiving) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXML(e, start, p.stats())
+}
+
+func (p *PlayerReceiving) UnmarshalJSON(data []byte) error {
+	return sportsdata.UnmarshalStatsJSON(data, p.stats())
+}
+
+func (p *PlayerReceiving) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSON(p.stats())
+}
+
+type ReceivingStatistics struct {
+	Receiving
+	Players []*PlayerReceiving
+}
+
+type receivingPlayers struct {
+	Players []*PlayerReceiving `xml:"player" json:"players"`
+}
+
+func (s *ReceivingStatistics) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v := new(receivingPlayers)
+	if err := sportsdata.UnmarshalStatsXMLElement(d, start, s.Receiving.stats(), v); err != nil {
+		return err
+	}
+	s.Players = v.Players
+	return nil
+}
+
+func (s *ReceivingStatistics) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXMLElement(e, start, s.Receiving.stats(), &receivingPlayers{Players: s.Players})
+}
+
+func (s *ReceivingStatistics) UnmarshalJSON(data []byte) error {
+	v := new(receivingPlayers)
+	if err := sportsdata.UnmarshalStatsJSONObject(data, s.Receiving.stats(), v); err != nil {
+		return err
+	}
+	s.Players = v.Players
+	return nil
+}
+
+func (s *ReceivingStatistics) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSONObject(s.Receiving.stats(), &receivingPlayers{Players: s.Players})
+}
+
+type Defense struct {
+	Tackles                int64
+	Assists                int64
+	Combined               int64
+	TacklesForLoss         int64
+	Sacks                  float64
+	SackYards              int64
+	QuarterbackHits        int64
+	Interceptions          int64
+	InterceptionYards      int64
+	InterceptionTouchdowns int64
+	PassesDefended         int64
+	ForcedFumbles          int64
+	FumbleRecoveries       int64
+	Safeties               int64
+}
+
+func (s *Defense) stats() []sportsdata.Stat {
+	return []sportsdata.Stat{
+		sportsdata.IntStat("tackle", &s.Tackles),
+		sportsdata.IntStat("ast", &s.Assists),
+		sportsdata.IntStat("comb", &s.Combined),
+		sportsdata.IntStat("tlost", &s.TacklesForLoss),
+		sportsdata.FloatStat("sack", &s.Sacks),
+		sportsdata.IntStat("sack_yds", &s.SackYards),
+		sportsdata.IntStat("qh", &s.QuarterbackHits),
+		sportsdata.IntStat("int", &s.Interceptions),
+		sportsdata.IntStat("int_yds", &s.InterceptionYards),
+		sportsdata.IntStat("int_td", &s.InterceptionTouchdowns),
+		sportsdata.IntStat("pd", &s.PassesDefended),
+		sportsdata.IntStat("force_fum", &s.ForcedFumbles),
+		sportsdata.IntStat("fum_rec", &s.FumbleRecoveries),
+		sportsdata.IntStat("sfty", &s.Safeties),
+	}
+}
+
+type PlayerDefense struct {
+	StatisticsPlayer
+	Defense
+}
+
+func (p *PlayerDefense) stats() []sportsdata.Stat {
+	return append(p.StatisticsPlayer.stats(), p.Defense.stats()...)
+}
+
+func (p *PlayerDefense) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return sportsdata.UnmarshalStatsXML(d, start, p.stats())
+}
+
+func (p *PlayerDefense) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXML(e, start, p.stats())
+}
+
+func (p *PlayerDefense) UnmarshalJSON(data []byte) error {
+	return sportsdata.UnmarshalStatsJSON(data, p.stats())
+}
+
+func (p *PlayerDefense) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSON(p.stats())
+}
+
+type DefenseStatistics struct {
+	Defense
+	Players []*PlayerDefense
+}
+
+type defensePlayers struct {
+	Players []*PlayerDefense `xml:"player" json:"players"`
+}
+
+func (s *DefenseStatistics) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v := new(defensePlayers)
+	if err := sportsdata.UnmarshalStatsXMLElement(d, start, s.Defense.stats(), v); err != nil {
+		return err
+	}
+	s.Players = v.Players
+	return nil
+}
+
+func (s *DefenseStatistics) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXMLElement(e, start, s.Defense.stats(), &defensePlayers{Players: s.Players})
+}
+
+func (s *DefenseStatistics) UnmarshalJSON(data []byte) error {
+	v := new(defensePlayers)
+	if err := sportsdata.UnmarshalStatsJSONObject(data, s.Defense.stats(), v); err != nil {
+		return err
+	}
+	s.Players = v.Players
+	return nil
+}
+
+func (s *DefenseStatistics) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSONObject(s.Defense.stats(), &defensePlayers{Players: s.Players})
+}
+
+type Kicking struct {
+	FieldGoalAttempts  int64
+	FieldGoalsMade     int64
+	FieldGoalPercent   float64
+	Long               int64
+	ExtraPointAttempts int64
+	ExtraPointsMade    int64
+	Points             int64
+}
+
+func (s *Kicking) stats() []sportsdata.Stat {
+	return []sportsdata.Stat{
+		sportsdata.IntStat("fga", &s.FieldGoalAttempts),
+		sportsdata.IntStat("fgm", &s.FieldGoalsMade),
+		sportsdata.FloatStat("fg_pct", &s.FieldGoalPercent),
+		sportsdata.IntStat("lg", &s.Long),
+		sportsdata.IntStat("xpa", &s.ExtraPointAttempts),
+		sportsdata.IntStat("xpm", &s.ExtraPointsMade),
+		sportsdata.IntStat("pts", &s.Points),
+	}
+}
+
+type PlayerKicking struct {
+	StatisticsPlayer
+	Kicking
+}
+
+func (p *PlayerKicking) stats() []sportsdata.Stat {
+	return append(p.StatisticsPlayer.stats(), p.Kicking.stats()...)
+}
+
+func (p *PlayerKicking) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return sportsdata.UnmarshalStatsXML(d, start, p.stats())
+}
+
+func (p *PlayerKicking) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXML(e, start, p.stats())
+}
+
+func (p *PlayerKicking) UnmarshalJSON(data []byte) error {
+	return sportsdata.UnmarshalStatsJSON(data, p.stats())
+}
+
+func (p *PlayerKicking) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSON(p.stats())
+}
+
+type KickingStatistics struct {
+	Kicking
+	Players []*PlayerKicking
+}
+
+type kickingPlayers struct {
+	Players []*PlayerKicking `xml:"player" json:"players"`
+}
+
+func (s *KickingStatistics) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v := new(kickingPlayers)
+	if err := sportsdata.UnmarshalStatsXMLElement(d, start, s.Kicking.stats(), v); err != nil {
+		return err
+	}
+	s.Players = v.Players
+	return nil
+}
+
+func (s *KickingStatistics) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXMLElement(e, start, s.Kicking.stats(), &kickingPlayers{Players: s.Players})
+}
+
+func (s *KickingStatistics) UnmarshalJSON(data []byte) error {
+	v := new(kickingPlayers)
+	if err := sportsdata.UnmarshalStatsJSONObject(data, s.Kicking.stats(), v); err != nil {
+		return err
+	}
+	s.Players = v.Players
+	return nil
+}
+
+func (s *KickingStatistics) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSONObject(s.Kicking.stats(), &kickingPlayers{Players: s.Players})
+}
+
+type Punting struct {
+	Punts      int64
+	Yards      int64
+	Average    float64
+	Long       int64
+	Inside20   int64
+	Touchbacks int64
+	Blocked    int64
+}
+
+func (s *Punting) stats() []sportsdata.Stat {
+	return []sportsdata.Stat{
+		sportsdata.IntStat("punts", &s.Punts),
+		sportsdata.IntStat("yds", &s.Yards),
+		sportsdata.FloatStat("avg", &s.Average),
+		sportsdata.IntStat("lg", &s.Long),
+		sportsdata.IntStat("in20", &s.Inside20),
+		sportsdata.IntStat("tb", &s.Touchbacks),
+		sportsdata.IntStat("blk", &s.Blocked),
+	}
+}
+
+type PlayerPunting struct {
+	StatisticsPlayer
+	Punting
+}
+
+func (p *PlayerPunting) stats() []sportsdata.Stat {
+	return append(p.StatisticsPlayer.stats(), p.Punting.stats()...)
+}
+
+func (p *PlayerPunting) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return sportsdata.UnmarshalStatsXML(d, start, p.stats())
+}
+
+func (p *PlayerPunting) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXML(e, start, p.stats())
+}
+
+func (p *PlayerPunting) UnmarshalJSON(data []byte) error {
+	return sportsdata.UnmarshalStatsJSON(data, p.stats())
+}
+
+func (p *PlayerPunting) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSON(p.stats())
+}
+
+type PuntingStatistics struct {
+	Punting
+	Players []*PlayerPunting
+}
+
+type puntingPlayers struct {
+	Players []*PlayerPunting `xml:"player" json:"players"`
+}
+
+func (s *PuntingStatistics) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v := new(puntingPlayers)
+	if err := sportsdata.UnmarshalStatsXMLElement(d, start, s.Punting.stats(), v); err != nil {
+		return err
+	}
+	s.Players = v.Players
+	return nil
+}
+
+func (s *PuntingStatistics) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXMLElement(e, start, s.Punting.stats(), &puntingPlayers{Players: s.Players})
+}
+
+func (s *PuntingStatistics) UnmarshalJSON(data []byte) error {
+	v := new(puntingPlayers)
+	if err := sportsdata.UnmarshalStatsJSONObject(data, s.Punting.stats(), v); err != nil {
+		return err
+	}
+	s.Players = v.Players
+	return nil
+}
+
+func (s *PuntingStatistics) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSONObject(s.Punting.stats(), &puntingPlayers{Players: s.Players})
+}
+
+type Return struct {
+	Returns     int64
+	Yards       int64
+	Average     float64
+	Long        int64
+	Touchdowns  int64
+	FairCatches int64
+}
+
+func (s *Return) stats() []sportsdata.Stat {
+	return []sportsdata.Stat{
+		sportsdata.IntStat("num", &s.Returns),
+		sportsdata.IntStat("yds", &s.Yards),
+		sportsdata.FloatStat("avg", &s.Average),
+		sportsdata.IntStat("lg", &s.Long),
+		sportsdata.IntStat("td", &s.Touchdowns),
+		sportsdata.IntStat("fc", &s.FairCatches),
+	}
+}
+
+type PlayerReturn struct {
+	StatisticsPlayer
+	Return
+}
+
+func (p *PlayerReturn) stats() []sportsdata.Stat {
+	return append(p.StatisticsPlayer.stats(), p.Return.stats()...)
+}
+
+func (p *PlayerReturn) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return sportsdata.UnmarshalStatsXML(d, start, p.stats())
+}
+
+func (p *PlayerReturn) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXML(e, start, p.stats())
+}
+
+func (p *PlayerReturn) UnmarshalJSON(data []byte) error {
+	return sportsdata.UnmarshalStatsJSON(data, p.stats())
+}
+
+func (p *PlayerReturn) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSON(p.stats())
+}
+
+// ReturnStatistics holds either punt or kick returns.
+type ReturnStatistics struct {
+	Return
+	Players []*PlayerReturn
+}
+
+type returnPlayers struct {
+	Players []*PlayerReturn `xml:"player" json:"players"`
+}
+
+func (s *ReturnStatistics) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v := new(returnPlayers)
+	if err := sportsdata.UnmarshalStatsXMLElement(d, start, s.Return.stats(), v); err != nil {
+		return err
+	}
+	s.Players = v.Players
+	return nil
+}
+
+func (s *ReturnStatistics) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXMLElement(e, start, s.Return.stats(), &returnPlayers{Players: s.Players})
+}
+
+func (s *ReturnStatistics) UnmarshalJSON(data []byte) error {
+	v := new(returnPlayers)
+	if err := sportsdata.UnmarshalStatsJSONObject(data, s.Return.stats(), v); err != nil {
+		return err
+	}
+	s.Players = v.Players
+	return nil
+}
+
+func (s *ReturnStatistics) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSONObject(s.Return.stats(), &returnPlayers{Players: s.Players})
+}
+
+type Penalty struct {
+	Penalties int64
+	Yards     int64
+}
+
+func (s *Penalty) stats() []sportsdata.Stat {
+	return []sportsdata.Stat{
+		sportsdata.IntStat("num", &s.Penalties),
+		sportsdata.IntStat("yds", &s.Yards),
+	}
+}
+
+type PlayerPenalty struct {
+	StatisticsPlayer
+	Penalty
+}
+
+func (p *PlayerPenalty) stats() []sportsdata.Stat {
+	return append(p.StatisticsPlayer.stats(), p.Penalty.stats()...)
+}
+
+func (p *PlayerPenalty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return sportsdata.UnmarshalStatsXML(d, start, p.stats())
+}
+
+func (p *PlayerPenalty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXML(e, start, p.stats())
+}
+
+func (p *PlayerPenalty) UnmarshalJSON(data []byte) error {
+	return sportsdata.UnmarshalStatsJSON(data, p.stats())
+}
+
+func (p *PlayerPenalty) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSON(p.stats())
+}
+
+type PenaltyStatistics struct {
+	Penalty
+	Players []*PlayerPenalty
+}
+
+type penaltyPlayers struct {
+	Players []*PlayerPenalty `xml:"player" json:"players"`
+}
+
+func (s *PenaltyStatistics) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v := new(penaltyPlayers)
+	if err := sportsdata.UnmarshalStatsXMLElement(d, start, s.Penalty.stats(), v); err != nil {
+		return err
+	}
+	s.Players = v.Players
+	return nil
+}
+
+func (s *PenaltyStatistics) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXMLElement(e, start, s.Penalty.stats(), &penaltyPlayers{Players: s.Players})
+}
+
+func (s *PenaltyStatistics) UnmarshalJSON(data []byte) error {
+	v := new(penaltyPlayers)
+	if err := sportsdata.UnmarshalStatsJSONObject(data, s.Penalty.stats(), v); err != nil {
+		return err
+	}
+	s.Players = v.Players
+	return nil
+}
+
+func (s *PenaltyStatistics) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSONObject(s.Penalty.stats(), &penaltyPlayers{Players: s.Players})
+}
+
+// PlayByPlay is the play-by-play feed of a game, organized by quarter and
+// drive.
+type PlayByPlay struct {
+	Year         string               `xml:"-" json:"-"`
+	ScheduleType ScheduleType         `xml:"-" json:"-"`
+	Week         string               `xml:"-" json:"-"`
+	XMLNS        string               `xml:"xmlns,attr" json:"-"`
+	Id           string               `xml:"id,attr" json:"id"`
+	Scheduled    string               `xml:"scheduled,attr" json:"scheduled"`
+	HomeTeamId   string               `xml:"home,attr" json:"home"`
+	AwayTeamId   string               `xml:"away,attr" json:"away"`
+	Status       string               `xml:"status,attr" json:"status"`
+	Quarters     []*PlayByPlayQuarter `xml:"quarter" json:"quarters"`
+}
+
+// Closed reports whether the game is final and its plays verified.
+func (p *PlayByPlay) Closed() bool {
+	return p.Status == "closed"
+}
+
+// Drives returns every drive of the game in order.
+func (p *PlayByPlay) Drives() []*Drive {
+	drives := make([]*Drive, 0)
+	for _, q := range p.Quarters {
+		for _, d := range q.Drives {
+			drives = append(drives, d)
+		}
+	}
+	return drives
+}
+
+// Plays returns every play of the game in order.
+func (p *PlayByPlay) Plays() []*Play {
+	plays := make([]*Play, 0)
+	for _, d := range p.Drives() {
+		for _, play := range d.Plays {
+			plays = append(plays, play)
+		}
+	}
+	return plays
+}
+
+type PlayByPlayQuarter struct {
+	Number int64    `xml:"number,attr" json:"number"`
+	Drives []*Drive `xml:"drive" json:"drives"`
+}
+
+type Drive struct {
+	Sequence int64   `xml:"sequence,attr" json:"sequence"`
+	Clock    string  `xml:"clock,attr" json:"clock"`
+	Team     string  `xml:"team,attr" json:"team"`
+	Result   string  `xml:"result,attr" json:"result"`
+	Plays    []*Play `xml:"play" json:"plays"`
+}
+
+type Play struct {
+	Id           string             `xml:"id,attr" json:"id"`
+	Sequence     int64              `xml:"sequence,attr" json:"sequence"`
+	Clock        string             `xml:"clock,attr" json:"clock"`
+	PlayType     string             `xml:"type,attr" json:"type"`
+	Team         string             `xml:"team,attr" json:"team"`
+	Down         int64              `xml:"down,attr" json:"down"`
+	Distance     int64              `xml:"yfd,attr" json:"yfd"`
+	Side         string             `xml:"side,attr" json:"side"`
+	YardLine     int64              `xml:"yard_line,attr" json:"yard_line"`
+	Summary      *BoxscoreSummary   `xml:"summary" json:"summary"`
+	Participants []*PlayParticipant `xml:"participants>player" json:"participants"`
+	Links        *Links             `xml:"links" json:"links"`
+}
+
+type PlayParticipant struct {
+	Id       string `xml:"id,attr" json:"id"`
+	Name     string `xml:"name,attr" json:"name"`
+	Jersey   string `xml:"jersey,attr" json:"jersey"`
+	Position string `xml:"position,attr" json:"position"`
+	Team     string `xml:"team,attr" json:"team"`
+}
+
+type Player struct {
+	Id        string `xml:"id,attr" json:"id"`
+	TeamId    string `xml:"-" json:"-"`
+	Name      string `xml:"name_full,attr" json:"name_full"`
+	FirstName string `xml:"name_first,attr" json:"name_first"`
+	LastName  string `xml:"name_last,attr" json:"name_last"`
+	Jersey    string `xml:"jersey,attr" json:"jersey"`
+	Position  string `xml:"position,attr" json:"position"`
+	Class     string `xml:"class,attr" json:"class"`
+	// Height is in inches and Weight in pounds.
+	Height   int64  `xml:"height,attr" json:"height"`
+	Weight   int64  `xml:"weight,attr" json:"weight"`
+	Hometown string `xml:"hometown,attr" json:"hometown"`
+}
+
+// TeamRoster lists the players of a team. Its Id is the id used by
+// Division.Teams.
+type TeamRoster struct {
+	XMLNS   string    `xml:"xmlns,attr" json:"-"`
+	Id      string    `xml:"id,attr" json:"id"`
+	Name    string    `xml:"name,attr" json:"name"`
+	Market  string    `xml:"market,attr" json:"market"`
+	Players []*Player `xml:"player" json:"players"`
+}
+
+func (r *TeamRoster) setTeamIds() {
+	for _, p := range r.Players {
+		p.TeamId = r.Id
+	}
+}
+
+// GameRoster is the roster feed of a game, listing the players of both teams.
+type GameRoster struct {
+	Year         string        `xml:"-" json:"-"`
+	ScheduleType ScheduleType  `xml:"-" json:"-"`
+	Week         string        `xml:"-" json:"-"`
+	XMLNS        string        `xml:"xmlns,attr" json:"-"`
+	Id           string        `xml:"id,attr" json:"id"`
+	Scheduled    string        `xml:"scheduled,attr" json:"scheduled"`
+	HomeTeamId   string        `xml:"home,attr" json:"home"`
+	AwayTeamId   string        `xml:"away,attr" json:"away"`
+	Status       string        `xml:"status,attr" json:"status"`
+	Teams        []*TeamRoster `xml:"team" json:"teams"`
+}
+
+func (r *GameRoster) HomeTeam() *TeamRoster {
+	for _, t := range r.Teams {
+		if t.Id == r.HomeTeamId {
+			return t
+		}
+	}
+	return nil
+}
+
+func (r *GameRoster) AwayTeam() *TeamRoster {
+	for _, t := range r.Teams {
+		if t.Id == r.AwayTeamId {
+			return t
+		}
+	}
+	return nil
+}
+
+// Players returns the players of both teams with their TeamId set.
+func (r *GameRoster) Players() []*Player {
+	players := make([]*Player, 0)
+	for _, team := range r.Teams {
+		team.setTeamIds()
+		for _, p := range team.Players {
+			players = append(players, p)
+		}
+	}
+	return players
+}
+
+// GameSummary is the summary feed of a game: its state, score and
+// conditions without statistics.
+type GameSummary struct {
+	Year         string            `xml:"-" json:"-"`
+	ScheduleType ScheduleType      `xml:"-" json:"-"`
+	Week         string            `xml:"-" json:"-"`
+	XMLNS        string            `xml:"xmlns,attr" json:"-"`
+	Id           string            `xml:"id,attr" json:"id"`
+	Scheduled    string            `xml:"scheduled,attr" json:"scheduled"`
+	HomeTeamId   string            `xml:"home,attr" json:"home"`
+	AwayTeamId   string            `xml:"away,attr" json:"away"`
+	Status       string            `xml:"status,attr" json:"status"`
+	Quarter      int64             `xml:"quarter,attr" json:"quarter"`
+	Clock        string            `xml:"clock,attr" json:"clock"`
+	Attendance   int64             `xml:"attendance,attr" json:"attendance"`
+	Venue        *sportsdata.Venue `xml:"venue" json:"venue"`
+	Weather      *Weather          `xml:"weather" json:"weather"`
+	Situation    *Situation        `xml:"situation" json:"situation"`
+	Teams        []*SummaryTeam    `xml:"team" json:"teams"`
+}
+
+func (s *GameSummary) FormattedScheduled() (time.Time, error) {
+	return time.Parse(sportsdata.SportsDataTimeFormat, s.Scheduled)
+}
+
+// Closed reports whether the game is final and its score verified.
+func (s *GameSummary) Closed() bool {
+	return s.Status == "closed"
+}
+
+func (s *GameSummary) HomeTeam() *SummaryTeam {
+	for _, t := range s.Teams {
+		if t.Id == s.HomeTeamId {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s *GameSummary) AwayTeam() *SummaryTeam {
+	for _, t := range s.Teams {
+		if t.Id == s.AwayTeamId {
+			return t
+		}
+	}
+	return nil
+}
+
+// Situation is the down and distance of a game in progress.
+type Situation struct {
+	Clock      string `xml:"clock,attr" json:"clock"`
+	Possession string `xml:"possession,attr" json:"possession"`
+	Down       int64  `xml:"down,attr" json:"down"`
+	Distance   int64  `xml:"yfd,attr" json:"yfd"`
+	Side       string `xml:"side,attr" json:"side"`
+	YardLine   int64  `xml:"yard_line,attr" json:"yard_line"`
+}
+
+type SummaryTeam struct {
+	Id                  string `xml:"id,attr" json:"id"`
+	Name                string `xml:"name,attr" json:"name"`
+	Market              string `xml:"market,attr" json:"market"`
+	Points              int64  `xml:"points,attr" json:"points"`
+	RemainingTimeouts   int64  `xml:"remaining_timeouts,attr" json:"remaining_timeouts"`
+	RemainingChallenges int64  `xml:"remaining_challenges,attr" json:"remaining_challenges"`
 }
@@ -1,6 +1,7 @@
 package ncaafb
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"testing"
 	"time"
@@ -231,6 +232,129 @@ const boxscoreData = `
 </game>
 `
 
+const statisticsData = `
+<game xmlns="http://feed.elasticstats.com/schema/ncaafb/statistics-v1.0.xsd" id="e5896e5f-3779-4726-bee9-512d9d0746b2" scheduled="2014-09-18T23:30:00+00:00" home="KST" away="AUB" status="closed">
+  <team id="KST" name="Wildcats" market="Kansas State">
+    <first_downs num="19" rush="8" pass="10" penalty="1"/>
+    <third_down_efficiency att="15" conv="6" pct="40.0"/>
+    <fourth_down_efficiency att="2" conv="0" pct="0.0"/>
+    <rushing att="32" yds="109" avg="3.4" lg="14" td="1">
+      <player id="4d9f2b1e-6e2a-4c4b-8c4b-0b8b4a2d3e11" name="Jake Waters" jersey="15" position="QB" att="14" yds="51" avg="3.6" lg="14" td="0"/>
+      <player id="7a2c9e40-12f4-4a5b-9d0c-51f6a5e0c2b7" name="Charles Jones" jersey="2" position="RB" att="13" yds="40" avg="3.1" lg="9" td="1"/>
+    </rushing>
+    <passing att="42" cmp="26" pct="61.9" yds="245" avg="5.8" td="1" int="1" sk="3" sk_yds="17" lg="45" rating="118.4">
+      <player id="4d9f2b1e-6e2a-4c4b-8c4b-0b8b4a2d3e11" name="Jake Waters" jersey="15" position="QB" att="42" cmp="26" pct="61.9" yds="245" avg="5.8" td="1" int="1" sk="3" sk_yds="17" lg="45" rating="118.4"/>
+    </passing>
+    <receiving tar="40" rec="26" yds="245" avg="9.4" lg="45" td="1" yac="88"/>
+    <defense tackle="41" ast="22" comb="63" tlost="4" sack="2.5" sack_yds="14" qh="4" int="0" int_yds="0" int_td="0" pd="5" force_fum="1" fum_rec="0" sfty="0"/>
+    <kicking fga="3" fgm="0" fg_pct="0.0" lg="0" xpa="2" xpm="2" pts="2"/>
+    <punting punts="4" yds="171" avg="42.8" lg="51" in20="2" tb="0" blk="0"/>
+    <punt_return num="1" yds="7" avg="7.0" lg="7" td="0" fc="1"/>
+    <kick_return num="3" yds="66" avg="22.0" lg="27" td="0" fc="0"/>
+    <penalty num="4" yds="35"/>
+  </team>
+  <team id="AUB" name="Tigers" market="Auburn">
+    <first_downs num="20" rush="11" pass="8" penalty="1"/>
+    <third_down_efficiency att="13" conv="7" pct="53.8"/>
+    <rushing att="42" yds="128" avg="3.0" lg="23" td="1"/>
+    <passing att="21" cmp="13" pct="61.9" yds="231" avg="11.0" td="1" int="1" sk="1" sk_yds="6" lg="39" rating="158.2"/>
+  </team>
+</game>
+`
+
+const playByPlayData = `
+<game xmlns="http://feed.elasticstats.com/schema/ncaafb/pbp-v1.0.xsd" id="e5896e5f-3779-4726-bee9-512d9d0746b2" scheduled="2014-09-18T23:30:00+00:00" home="KST" away="AUB" status="closed">
+  <quarter number="1">
+    <drive sequence="1" clock="15:00" team="AUB" result="Field Goal">
+      <play id="f3b1c7a4-3c1d-4d7e-9f60-3a2e1b9d0c01" sequence="1" clock="15:00" type="kick" team="KST" side="KST" yard_line="35" down="" yfd="">
+        <summary><![CDATA[36-J.Cantele kicks 65 yards from KST 35. Touchback.]]></summary>
+      </play>
+      <play id="f3b1c7a4-3c1d-4d7e-9f60-3a2e1b9d0c02" sequence="2" clock="15:00" type="rush" team="AUB" side="AUB" yard_line="25" down="1" yfd="10">
+        <summary><![CDATA[14-N.Marshall runs 6 yards to AUB 31.]]></summary>
+        <participants>
+          <player id="b0a63cbb-3e2c-4f4e-bb7b-8e5d8d1f0c11" name="Nick Marshall" jersey="14" position="QB" team="AUB"/>
+          <player id="c2d4e6f8-1a3b-4c5d-8e7f-9a0b1c2d3e4f" name="Dante Barnett" jersey="20" position="DB" team="KST"/>
+        </participants>
+      </play>
+    </drive>
+    <drive sequence="2" clock="11:19" team="KST" result="Punt">
+      <play id="f3b1c7a4-3c1d-4d7e-9f60-3a2e1b9d0c03" sequence="3" clock="11:19" type="pass" team="KST" side="KST" yard_line="25" down="3" yfd="7"/>
+    </drive>
+  </quarter>
+  <quarter number="2">
+    <drive sequence="3" clock="14:02" team="AUB" result="Touchdown"/>
+  </quarter>
+</game>
+`
+
+const gameRosterData = `
+<game xmlns="http://feed.elasticstats.com/schema/ncaafb/roster-v1.0.xsd" id="e5896e5f-3779-4726-bee9-512d9d0746b2" scheduled="2014-09-18T23:30:00+00:00" home="KST" away="AUB" status="closed">
+  <team id="KST" name="Wildcats" market="Kansas State">
+    <player id="4d9f2b1e-6e2a-4c4b-8c4b-0b8b4a2d3e11" name_full="Jake Waters" name_first="Jake" name_last="Waters" jersey="15" position="QB" class="SR" height="73" weight="210" hometown="Council Bluffs, IA"/>
+    <player id="9e3b0c6d-2f4a-4b8e-a1c3-7d5e9f0b2a44" name_full="Tyler Lockett" name_first="Tyler" name_last="Lockett" jersey="16" position="WR" class="SR" height="70" weight="175" hometown="Tulsa, OK"/>
+  </team>
+  <team id="AUB" name="Tigers" market="Auburn">
+    <player id="b0a63cbb-3e2c-4f4e-bb7b-8e5d8d1f0c11" name_full="Nick Marshall" name_first="Nick" name_last="Marshall" jersey="14" position="QB" class="SR" height="73" weight="210" hometown="Pineview, GA"/>
+  </team>
+</game>
+`
+
+const gameSummaryData = `
+<game xmlns="http://feed.elasticstats.com/schema/ncaafb/summary-v1.0.xsd" id="e5896e5f-3779-4726-bee9-512d9d0746b2" scheduled="2014-09-18T23:30:00+00:00" home="KST" away="AUB" status="inprogress" quarter="3" clock="8:41" attendance="53351">
+  <venue id="0c7d2d4c-7f2c-4b29-9e9a-6e1a57ffad5c" country="USA" name="Bill Snyder Family Stadium" city="Manhattan" state="KS" capacity="50000" surface="artificial" type="outdoor" zip="66502" address="1800 College Avenue"/>
+  <weather temperature="72" condition="Clear" humidity="48">
+    <wind speed="9" direction="S"/>
+  </weather>
+  <situation clock="8:41" possession="AUB" down="2" yfd="7" side="KST" yard_line="38"/>
+  <team id="KST" name="Wildcats" market="Kansas State" points="7" remaining_timeouts="3" remaining_challenges="1"/>
+  <team id="AUB" name="Tigers" market="Auburn" points="10" remaining_timeouts="2" remaining_challenges="1"/>
+</game>
+`
+
+const seasonJSONData = `
+{
+	"season": 2014,
+	"type": "REG",
+	"weeks": [
+		{
+			"number": 1,
+			"games": [
+				{
+					"id": "92044ce9-3698-443d-88a9-47967462dd61",
+					"scheduled": "2014-08-23T19:30:00+00:00",
+					"coverage": "full",
+					"home_rotation": "",
+					"away_rotation": "",
+					"home": "EW",
+					"away": "SHS",
+					"status": "closed",
+					"venue": {"id": "61b61700-a5e3-4f72-9cce-e0e6c3e652fa", "country": "USA", "name": "Roos Field", "city": "Cheney", "state": "WA", "capacity": 8600, "surface": "artificial", "type": "outdoor", "zip": "99004", "address": "1136 Washington St."},
+					"weather": {"temperature": 69, "condition": "Sunny", "humidity": 37, "wind": {"speed": 12, "direction": "NE"}},
+					"broadcast": {"network": "ESPN", "satellite": "206", "internet": "WatchESPN", "cable": ""},
+					"links": [
+						{"rel": "statistics", "href": "/2014/REG/1/SHS/EW/statistics.json", "type": "application/json"},
+						{"rel": "boxscore", "href": "/2014/REG/1/SHS/EW/boxscore.json", "type": "application/json"}
+					]
+				}
+			]
+		}
+	]
+}
+`
+
+const blankStatisticsData = `
+<game xmlns="http://feed.elasticstats.com/schema/ncaafb/statistics-v1.0.xsd" id="e5896e5f-3779-4726-bee9-512d9d0746b2" scheduled="2014-09-18T23:30:00+00:00" home="KST" away="AUB" status="inprogress">
+  <team id="KST" name="Wildcats" market="Kansas State">
+    <third_down_efficiency att="0" conv="0" pct=""/>
+    <rushing att="1" yds="-2" avg="-2.0" lg="" td="0">
+      <player id="4d9f2b1e-6e2a-4c4b-8c4b-0b8b4a2d3e11" name="Jake Waters" jersey="15" position="QB" att="1" yds="-2" avg="-2.0" lg="" td="0"/>
+    </rushing>
+    <passing att="0" cmp="0" pct="N/A" yds="0" avg="NaN" td="0" int="0" sk="0" sk_yds="0" lg="" rating=""/>
+    <kicking fga="0" fgm="0" fg_pct="" lg="" xpa="1" xpm="1" pts="1"/>
+  </team>
+</game>
+`
+
 func TestDivisionConferences(t *testing.T) {
 	v := new(Division)
 	err := xml.Unmarshal([]byte(divisionConferenceData), v)
@@ -240,7 +364,7 @@ func TestDivisionConferences(t *testing.T) {
 	}
 	expectedDivisionId := "FBS"
 	if v.Id != expectedDivisionId {
-		t.Errorf("Expected division id %s, found %s\n", expectedDivisionId)
+		t.Errorf("Expected division id %s, found %s\n", expectedDivisionId, v.Id)
 		return
 	}
 	conferences := v.Conferences
@@ -314,6 +438,15 @@ func TestSeasons(t *testing.T) {
 		t.Errorf("Expected venue id %s, found %s\n", expectedVenueId, venue.Id)
 		return
 	}
+	weather := game.Weather
+	if weather == nil {
+		t.Errorf("Weather not found\n")
+		return
+	}
+	if weather.Temperature == nil || *weather.Temperature != 69 || weather.Humidity == nil || *weather.Humidity != 37 || weather.Wind.Speed != 12 || weather.Wind.Direction != "NE" {
+		t.Errorf("Expected weather %d degrees, %d%% humidity and wind %d NE, found %+v\n", 69, 37, 12, weather)
+		return
+	}
 	links := game.Links.Links
 	if len(links) != 5 {
 		t.Errorf("Expected %d links, found %d\n", 5, len(links))
@@ -371,3 +504,358 @@ func TestBoxscore(t *testing.T) {
 		return
 	}
 }
+
+func TestGameStatistics(t *testing.T) {
+	v := new(GameStatistics)
+	err := xml.Unmarshal([]byte(statisticsData), v)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	home := v.HomeTeam()
+	if home == nil {
+		t.Errorf("Expected home team, found nil\n")
+		return
+	}
+	if home.ThirdDownEfficiency == nil || home.ThirdDownEfficiency.Conversions != 6 || home.ThirdDownEfficiency.Percent != 40.0 {
+		t.Errorf("Expected %d third down conversions, found %+v\n", 6, home.ThirdDownEfficiency)
+		return
+	}
+	if home.Rushing.Yards != 109 || len(home.Rushing.Players) != 2 {
+		t.Errorf("Expected %d rushing yards by %d players, found %+v\n", 109, 2, home.Rushing)
+		return
+	}
+	rusher := home.Rushing.Players[1]
+	if rusher.Name != "Charles Jones" || rusher.Touchdowns != 1 || rusher.Average != 3.1 {
+		t.Errorf("Expected rushing line for %s, found %+v\n", "Charles Jones", rusher)
+		return
+	}
+	if home.Passing.Players[0].Rating != 118.4 {
+		t.Errorf("Expected passer rating %v, found %v\n", 118.4, home.Passing.Players[0].Rating)
+		return
+	}
+	if home.Defense.Sacks != 2.5 || home.Kicking.FieldGoalAttempts != 3 || home.PuntReturns.FairCatches != 1 || home.KickReturns.Yards != 66 {
+		t.Errorf("Expected defense, kicking and return totals, found %+v\n", home)
+		return
+	}
+	if home.Penalties.Penalties != 4 || home.FirstDowns.Passing != 10 {
+		t.Errorf("Expected %d penalties and %d passing first downs, found %+v %+v\n", 4, 10, home.Penalties, home.FirstDowns)
+		return
+	}
+	away := v.AwayTeam()
+	if away == nil || away.Passing.Yards != 231 || away.Defense != nil {
+		t.Errorf("Expected away passing yards %d and no defense, found %+v\n", 231, away)
+		return
+	}
+}
+
+func TestPlayByPlay(t *testing.T) {
+	v := new(PlayByPlay)
+	err := xml.Unmarshal([]byte(playByPlayData), v)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if len(v.Quarters) != 2 {
+		t.Errorf("Expected %d quarters, found %d\n", 2, len(v.Quarters))
+		return
+	}
+	drives := v.Drives()
+	if len(drives) != 3 {
+		t.Errorf("Expected %d drives, found %d\n", 3, len(drives))
+		return
+	}
+	if drives[1].Result != "Punt" || drives[1].Team != "KST" {
+		t.Errorf("Expected KST punt drive, found %+v\n", drives[1])
+		return
+	}
+	plays := v.Plays()
+	if len(plays) != 3 {
+		t.Errorf("Expected %d plays, found %d\n", 3, len(plays))
+		return
+	}
+	kickoff := plays[0]
+	if kickoff.Down != 0 || kickoff.PlayType != "kick" {
+		t.Errorf("Expected kickoff without down, found %+v\n", kickoff)
+		return
+	}
+	rush := plays[1]
+	if rush.Down != 1 || rush.Distance != 10 || rush.Side != "AUB" || rush.YardLine != 25 || rush.Clock != "15:00" {
+		t.Errorf("Expected 1st and 10 at AUB 25, found %+v\n", rush)
+		return
+	}
+	if len(rush.Participants) != 2 || rush.Participants[0].Name != "Nick Marshall" {
+		t.Errorf("Expected %d participants, found %+v\n", 2, rush.Participants)
+		return
+	}
+	expectedSummary := "14-N.Marshall runs 6 yards to AUB 31."
+	if rush.Summary == nil || rush.Summary.Data != expectedSummary {
+		t.Errorf("Expected summary %s, found %+v\n", expectedSummary, rush.Summary)
+		return
+	}
+}
+
+func TestGameRoster(t *testing.T) {
+	v := new(GameRoster)
+	err := xml.Unmarshal([]byte(gameRosterData), v)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	home := v.HomeTeam()
+	if home == nil || len(home.Players) != 2 {
+		t.Errorf("Expected home team with %d players, found %+v\n", 2, home)
+		return
+	}
+	players := v.Players()
+	if len(players) != 3 {
+		t.Errorf("Expected %d players, found %d\n", 3, len(players))
+		return
+	}
+	player := players[2]
+	if player.TeamId != "AUB" || player.Name != "Nick Marshall" || player.Jersey != "14" || player.Class != "SR" {
+		t.Errorf("Expected Auburn player %s, found %+v\n", "Nick Marshall", player)
+		return
+	}
+	if player.Height != 73 || player.Weight != 210 || player.Hometown != "Pineview, GA" {
+		t.Errorf("Expected height, weight and hometown, found %+v\n", player)
+		return
+	}
+}
+
+func TestGameSummary(t *testing.T) {
+	v := new(GameSummary)
+	err := xml.Unmarshal([]byte(gameSummaryData), v)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if v.Closed() || v.Quarter != 3 || v.Clock != "8:41" || v.Attendance != 53351 {
+		t.Errorf("Expected game in progress in quarter %d, found %+v\n", 3, v)
+		return
+	}
+	situation := v.Situation
+	if situation == nil || situation.Possession != "AUB" || situation.Down != 2 || situation.Distance != 7 || situation.YardLine != 38 {
+		t.Errorf("Expected AUB 2nd and 7 at KST 38, found %+v\n", situation)
+		return
+	}
+	home, away := v.HomeTeam(), v.AwayTeam()
+	if home == nil || away == nil || home.Points != 7 || away.Points != 10 || away.RemainingTimeouts != 2 {
+		t.Errorf("Expected score 7-10, found %+v %+v\n", home, away)
+		return
+	}
+	if v.Venue == nil || v.Weather == nil {
+		t.Errorf("Expected venue and weather, found %+v\n", v)
+		return
+	}
+}
+
+func TestWeather(t *testing.T) {
+	data := `
+<season season="2014" type="REG">
+  <week week="1">
+    <game id="a" home="SYR" away="VILL" status="scheduled">
+      <weather temperature="" condition="Dome" humidity="">
+        <wind speed="" direction=""/>
+      </weather>
+    </game>
+    <game id="b" home="KST" away="AUB" status="scheduled">
+      <weather temperature="72.5" condition="Clear" humidity="N/A">
+        <wind speed="9.5" direction="South West"/>
+      </weather>
+    </game>
+  </week>
+</season>`
+	v := new(Season)
+	err := xml.Unmarshal([]byte(data), v)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	dome := v.Weeks[0].Games[0].Weather
+	if dome == nil || dome.Temperature != nil || dome.Humidity != nil || dome.Wind.Speed != 0 || dome.Condition != "Dome" {
+		t.Errorf("Expected dome without readings, found %+v\n", dome)
+		return
+	}
+	outdoor := v.Weeks[0].Games[1].Weather
+	if outdoor.Temperature == nil || *outdoor.Temperature != 72.5 || outdoor.Humidity != nil || outdoor.Wind.Speed != 9.5 || outdoor.Wind.Direction != "SW" {
+		t.Errorf("Expected %v degrees and wind %v SW, found %+v\n", 72.5, 9.5, outdoor)
+		return
+	}
+	encoded, err := json.Marshal(outdoor)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	decoded := new(Weather)
+	err = json.Unmarshal(encoded, decoded)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if decoded.Temperature == nil || *decoded.Temperature != 72.5 || decoded.Condition != "Clear" || decoded.Wind.Direction != "SW" {
+		t.Errorf("Expected %s, found %+v\n", encoded, decoded)
+		return
+	}
+	encoded, err = xml.Marshal(v)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	season := new(Season)
+	err = xml.Unmarshal(encoded, season)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if w := season.Weeks[0].Games[1].Weather; w == nil || *w.Temperature != 72.5 || w.Wind.Speed != 9.5 {
+		t.Errorf("Expected %s, found %+v\n", encoded, w)
+		return
+	}
+}
+
+func TestParseWindDirection(t *testing.T) {
+	for s, expected := range map[string]WindDirection{
+		"NE":              "NE",
+		"ne":              "NE",
+		"North East":      "NE",
+		"Northeast":       "NE",
+		"south-southwest": "SSW",
+		" W ":             "W",
+		"Calm":            "",
+		"":                "",
+	} {
+		if d := ParseWindDirection(s); d != expected {
+			t.Errorf("Expected direction %q for %q, found %q\n", expected, s, d)
+			return
+		}
+	}
+	degrees, ok := WindDirection("SW").Degrees()
+	if !ok || degrees != 225 {
+		t.Errorf("Expected %v degrees, found %v\n", 225.0, degrees)
+		return
+	}
+}
+
+func TestSeasonsJSON(t *testing.T) {
+	v := new(Season)
+	err := json.Unmarshal([]byte(seasonJSONData), v)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	expectedSeason := "2014"
+	if v.Season != expectedSeason {
+		t.Errorf("Expected season %s, found %s\n", expectedSeason, v.Season)
+		return
+	}
+	if len(v.Weeks) != 1 || v.Weeks[0].Week != "1" {
+		t.Errorf("Expected week %s, found %+v\n", "1", v.Weeks)
+		return
+	}
+	games := v.Weeks[0].Games
+	if len(games) != 1 {
+		t.Errorf("Expected %d game, found %d\n", 1, len(games))
+		return
+	}
+	game := games[0]
+	if game.HomeTeamId != "EW" || game.AwayTeamId != "SHS" {
+		t.Errorf("Expected %s at %s, found %s at %s\n", "SHS", "EW", game.AwayTeamId, game.HomeTeamId)
+		return
+	}
+	if game.Venue == nil || game.Venue.Capacity != 8600 {
+		t.Errorf("Expected venue capacity %d, found %+v\n", 8600, game.Venue)
+		return
+	}
+	weather := game.Weather
+	if weather == nil || weather.Temperature == nil || *weather.Temperature != 69 || weather.Wind.Direction != "NE" {
+		t.Errorf("Expected weather %d degrees and wind NE, found %+v\n", 69, weather)
+		return
+	}
+	link, ok := game.Links.Rel("boxscore")
+	if !ok || link.LinkType != "application/json" {
+		t.Errorf("Expected boxscore link of type %s, found %+v\n", "application/json", game.Links)
+		return
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	decoded := new(Season)
+	err = json.Unmarshal(data, decoded)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if decoded.Season != v.Season || len(decoded.Weeks) != 1 || decoded.Weeks[0].Week != "1" || len(decoded.Weeks[0].Games) != 1 {
+		t.Errorf("Expected season %+v, found %+v\n", v, decoded)
+		return
+	}
+}
+
+func TestGameStatisticsBlank(t *testing.T) {
+	v := new(GameStatistics)
+	err := xml.Unmarshal([]byte(blankStatisticsData), v)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	home := v.HomeTeam()
+	if home == nil {
+		t.Errorf("Expected home team, found nil\n")
+		return
+	}
+	if home.ThirdDownEfficiency == nil || home.ThirdDownEfficiency.Percent != 0 {
+		t.Errorf("Expected no third down percentage, found %+v\n", home.ThirdDownEfficiency)
+		return
+	}
+	if home.Rushing.Yards != -2 || home.Rushing.Average != -2 || home.Rushing.Long != 0 {
+		t.Errorf("Expected %d rushing yards and no long, found %+v\n", -2, home.Rushing)
+		return
+	}
+	rusher := home.Rushing.Players[0]
+	if rusher.Name != "Jake Waters" || rusher.Yards != -2 || rusher.Long != 0 {
+		t.Errorf("Expected rushing line for %s, found %+v\n", "Jake Waters", rusher)
+		return
+	}
+	if home.Passing.Percent != 0 || home.Passing.Average != 0 || home.Passing.Rating != 0 {
+		t.Errorf("Expected no passing percentage, average or rating, found %+v\n", home.Passing)
+		return
+	}
+	if home.Kicking.FieldGoalPercent != 0 || home.Kicking.Points != 1 {
+		t.Errorf("Expected %d kicking point and no field goal percentage, found %+v\n", 1, home.Kicking)
+		return
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	decoded := new(GameStatistics)
+	err = json.Unmarshal(data, decoded)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	decodedRusher := decoded.HomeTeam().Rushing.Players[0]
+	if decodedRusher.Name != rusher.Name || decodedRusher.Yards != rusher.Yards || decoded.HomeTeam().Kicking.Points != 1 {
+		t.Errorf("Expected rushing line %+v, found %+v\n", rusher, decodedRusher)
+		return
+	}
+	data, err = xml.Marshal(v)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	decoded = new(GameStatistics)
+	err = xml.Unmarshal(data, decoded)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if len(decoded.HomeTeam().Rushing.Players) != 1 || decoded.HomeTeam().Rushing.Players[0].Id != rusher.Id {
+		t.Errorf("Expected rushing line %+v, found %+v\n", rusher, decoded.HomeTeam().Rushing)
+		return
+	}
+}
@@ -0,0 +1,243 @@
+package ncaafb
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/tassl-app/sportsdata"
+)
+
+// DivisionResult pairs a requested division with its hierarchy or the error
+// fetching it.
+type DivisionResult struct {
+	DivisionType DivisionType
+	Division     *Division
+	Err          error
+}
+
+type DivisionResults []*DivisionResult
+
+// Divisions returns the divisions fetched successfully, in request order.
+func (r DivisionResults) Divisions() []*Division {
+	divisions := make([]*Division, 0)
+	for _, result := range r {
+		if result.Err == nil {
+			divisions = append(divisions, result.Division)
+		}
+	}
+	return divisions
+}
+
+// Failed returns the results that failed.
+func (r DivisionResults) Failed() DivisionResults {
+	failed := make(DivisionResults, 0)
+	for _, result := range r {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Err returns a *sportsdata.BatchError if any request failed, or nil.
+func (r DivisionResults) Err() error {
+	errs := make([]error, len(r))
+	for i, result := range r {
+		errs[i] = result.Err
+	}
+	return sportsdata.NewBatchError(errs)
+}
+
+// ScheduleResult pairs a requested year and schedule type with its schedule or
+// the error fetching it.
+type ScheduleResult struct {
+	Year         string
+	ScheduleType ScheduleType
+	Schedule     *Schedule
+	Err          error
+}
+
+type ScheduleResults []*ScheduleResult
+
+// Schedules returns the schedules fetched successfully, in request order.
+func (r ScheduleResults) Schedules() []*Schedule {
+	schedules := make([]*Schedule, 0)
+	for _, result := range r {
+		if result.Err == nil {
+			schedules = append(schedules, result.Schedule)
+		}
+	}
+	return schedules
+}
+
+// Failed returns the results that failed.
+func (r ScheduleResults) Failed() ScheduleResults {
+	failed := make(ScheduleResults, 0)
+	for _, result := range r {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Err returns a *sportsdata.BatchError if any request failed, or nil.
+func (r ScheduleResults) Err() error {
+	errs := make([]error, len(r))
+	for i, result := range r {
+		errs[i] = result.Err
+	}
+	return sportsdata.NewBatchError(errs)
+}
+
+// BoxscoreResult pairs a requested game with its boxscore or the error
+// fetching it.
+type BoxscoreResult struct {
+	GameId   string
+	Week     string
+	Game     *Game
+	Boxscore *Boxscore
+	Err      error
+}
+
+type BoxscoreResults []*BoxscoreResult
+
+// Boxscores returns the boxscores fetched successfully, in request order.
+func (r BoxscoreResults) Boxscores() []*Boxscore {
+	boxscores := make([]*Boxscore, 0)
+	for _, result := range r {
+		if result.Err == nil {
+			boxscores = append(boxscores, result.Boxscore)
+		}
+	}
+	return boxscores
+}
+
+// Failed returns the results that failed.
+func (r BoxscoreResults) Failed() BoxscoreResults {
+	failed := make(BoxscoreResults, 0)
+	for _, result := range r {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// FailedIds returns the ids of the games that failed, ready to be passed
+// back to ScheduleBoxscoresResults.
+func (r BoxscoreResults) FailedIds() []string {
+	ids := make([]string, 0)
+	for _, result := range r.Failed() {
+		ids = append(ids, result.GameId)
+	}
+	return ids
+}
+
+// Err returns a *sportsdata.BatchError if any request failed, or nil.
+func (r BoxscoreResults) Err() error {
+	errs := make([]error, len(r))
+	for i, result := range r {
+		errs[i] = result.Err
+	}
+	return sportsdata.NewBatchError(errs)
+}
+
+// AllDivisionsResults fetches every division, pairing each with its hierarchy
+// or error.
+func (a *API) AllDivisionsResults() DivisionResults {
+	return a.AllDivisionsResultsContext(context.Background())
+}
+
+func (a *API) AllDivisionsResultsContext(ctx context.Context) DivisionResults {
+	results := make(DivisionResults, len(DivisionAll))
+	for i, divisionType := range DivisionAll {
+		results[i] = &DivisionResult{DivisionType: divisionType}
+	}
+	err := sportsdata.ForEach(ctx, len(results), a.workers, func(ctx context.Context, i int) error {
+		result := results[i]
+		result.Division, result.Err = a.DivisionContext(ctx, result.DivisionType)
+		return result.Err
+	})
+	if batchErr, ok := err.(*sportsdata.BatchError); ok {
+		for i, err := range batchErr.Errors {
+			results[i].Err = err
+		}
+	}
+	return results
+}
+
+// AllSchedulesResults fetches every schedule type of years, pairing each with
+// its schedule or error.
+func (a *API) AllSchedulesResults(years []string) ScheduleResults {
+	return a.AllSchedulesResultsContext(context.Background(), years)
+}
+
+func (a *API) AllSchedulesResultsContext(ctx context.Context, years []string) ScheduleResults {
+	results := make(ScheduleResults, 0)
+	for _, year := range years {
+		for _, scheduleType := range ScheduleAll {
+			results = append(results, &ScheduleResult{Year: year, ScheduleType: scheduleType})
+		}
+	}
+	err := sportsdata.ForEach(ctx, len(results), a.workers, func(ctx context.Context, i int) error {
+		result := results[i]
+		result.Schedule, result.Err = a.ScheduleContext(ctx, result.Year, result.ScheduleType)
+		return result.Err
+	})
+	if batchErr, ok := err.(*sportsdata.BatchError); ok {
+		for i, err := range batchErr.Errors {
+			results[i].Err = err
+		}
+	}
+	return results
+}
+
+// ScheduleBoxscoresResults fetches the boxscores of the games in schedule
+// whose ids are listed, pairing each with its boxscore or error. There is a
+// result for every id, in the order of ids. Ids of games not in schedule fail
+// with an error wrapping sportsdata.ErrNotFound.
+func (a *API) ScheduleBoxscoresResults(schedule *Schedule, ids []string) BoxscoreResults {
+	return a.ScheduleBoxscoresResultsContext(context.Background(), schedule, ids)
+}
+
+func (a *API) ScheduleBoxscoresResultsContext(ctx context.Context, schedule *Schedule, ids []string) BoxscoreResults {
+	results := make(BoxscoreResults, len(ids))
+	for i, id := range ids {
+		results[i] = &BoxscoreResult{GameId: id}
+	}
+	for _, w := range schedule.Season.Weeks {
+		for _, g := range w.Games {
+			for _, result := range results {
+				if g.Id == result.GameId {
+					result.Week = w.Week
+					result.Game = g
+				}
+			}
+		}
+	}
+	for _, result := range results {
+		if result.Game == nil {
+			result.Err = fmt.Errorf("game %s not in schedule: %w", result.GameId, sportsdata.ErrNotFound)
+		}
+	}
+	err := sportsdata.ForEach(ctx, len(results), a.workers, func(ctx context.Context, i int) error {
+		result := results[i]
+		if result.Err != nil {
+			return result.Err
+		}
+		g := result.Game
+		if a.log {
+			log.Printf("Getting boxscore for %s: %s, %s, %s, %s, %s\n", g.Id, schedule.Year, schedule.ScheduleType, result.Week, g.AwayTeamId, g.HomeTeamId)
+		}
+		result.Boxscore, result.Err = a.BoxscoreContext(ctx, schedule.Year, schedule.ScheduleType, result.Week, g.AwayTeamId, g.HomeTeamId)
+		return result.Err
+	})
+	if batchErr, ok := err.(*sportsdata.BatchError); ok {
+		for i, err := range batchErr.Errors {
+			results[i].Err = err
+		}
+	}
+	return results
+}
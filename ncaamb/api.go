@@ -1,30 +1,160 @@
 package ncaamb
 
 import (
+	"bytes"
+	"context"
 	"encoding/xml"
-	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
+
+	"github.com/tassl-app/sportsdata"
 )
 
 type API struct {
 	apiKey     string
 	production bool
 	log        bool
+	client     *http.Client
+	limiter    *sportsdata.RateLimiter
+	limiterSet bool
+	retry      *sportsdata.RetryPolicy
+	baseURL    string
+	version    string
+	format     sportsdata.Format
+	cache      sportsdata.Cache
+	cacheTTL   sportsdata.CacheTTL
+	workers    int
 }
 
 func NewAPI(apiKey string, production, log bool) *API {
-	return &API{
-		apiKey:     apiKey,
-		production: production,
-		log:        log,
+	return NewAPIWithOptions(apiKey, WithProduction(production), WithLog(log))
+}
+
+// Option configures an API created with NewAPIWithOptions.
+type Option func(*API)
+
+// WithProduction selects the production access level instead of trial.
+func WithProduction(production bool) Option {
+	return func(a *API) {
+		a.production = production
+	}
+}
+
+// WithLog enables logging of endpoints and requests.
+func WithLog(log bool) Option {
+	return func(a *API) {
+		a.log = log
+	}
+}
+
+// WithHTTPClient sets the client used for every request. Use it to configure
+// timeouts, proxies or TLS. The default is http.DefaultClient.
+func WithHTTPClient(client *http.Client) Option {
+	return func(a *API) {
+		if client != nil {
+			a.client = client
+		}
+	}
+}
+
+// WithRateLimiter sets the limiter every request waits on. Share one limiter
+// between clients that use the same account. A nil limiter disables rate
+// limiting. The default allows the rate of the selected access level.
+func WithRateLimiter(limiter *sportsdata.RateLimiter) Option {
+	return func(a *API) {
+		a.limiter = limiter
+		a.limiterSet = true
+	}
+}
+
+// WithRetryPolicy sets how failed requests are retried. The default, nil,
+// makes a single attempt.
+func WithRetryPolicy(retry *sportsdata.RetryPolicy) Option {
+	return func(a *API) {
+		a.retry = retry
+	}
+}
+
+// WithBaseURL sets the scheme and host, and optionally a path prefix, that
+// endpoints are built on, such as a caching proxy or an httptest.Server. The
+// default is sportsdata.DefaultBaseURL.
+func WithBaseURL(baseURL string) Option {
+	return func(a *API) {
+		a.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithVersion sets the API version. The default is DefaultVersion.
+func WithVersion(version string) Option {
+	return func(a *API) {
+		a.version = version
+	}
+}
+
+// WithFormat sets the response format requested and decoded. The default is
+// sportsdata.FormatXML.
+func WithFormat(format sportsdata.Format) Option {
+	return func(a *API) {
+		a.format = format
+	}
+}
+
+// WithCache stores responses in cache and revalidates stale entries with
+// ETag and Last-Modified when the server provides them. Requests answered from
+// the cache do not wait on the rate limiter. Streamed schedules are buffered
+// in memory when a cache is set.
+func WithCache(cache sportsdata.Cache) Option {
+	return func(a *API) {
+		a.cache = cache
+	}
+}
+
+// WithCacheTTL sets how long cached responses stay fresh. The default is
+// sportsdata.DefaultCacheTTL.
+func WithCacheTTL(ttl sportsdata.CacheTTL) Option {
+	return func(a *API) {
+		if ttl != nil {
+			a.cacheTTL = ttl
+		}
+	}
+}
+
+// WithConcurrency sets how many requests batch methods such as AllSchedules
+// make at once. They still wait on the shared rate limiter. The default is 1.
+func WithConcurrency(workers int) Option {
+	return func(a *API) {
+		a.workers = workers
 	}
 }
 
+func NewAPIWithOptions(apiKey string, opts ...Option) *API {
+	a := &API{
+		apiKey:   apiKey,
+		client:   http.DefaultClient,
+		baseURL:  sportsdata.DefaultBaseURL,
+		version:  DefaultVersion,
+		format:   sportsdata.FormatXML,
+		cacheTTL: sportsdata.DefaultCacheTTL,
+		workers:  1,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	if !a.limiterSet {
+		a.limiter = sportsdata.NewAccessLevelRateLimiter(a.production)
+	}
+	return a
+}
+
+// DefaultVersion is the version of the ncaamb API used unless WithVersion is given.
+const DefaultVersion = "3"
+
 type AccessLevelType string
 
 const (
@@ -53,15 +183,17 @@ func (a *API) baseEndpoint() string {
 	} else {
 		accessLevel = AccessLevelTrial
 	}
-	endpoint := fmt.Sprintf("https://api.sportsdatallc.org/ncaamb-%s3", string(accessLevel))
+	endpoint := fmt.Sprintf("%s/ncaamb-%s%s", a.baseURL, string(accessLevel), a.version)
 	if a.log {
 		log.Printf("base endpoint: %+v\n", endpoint)
 	}
 	return endpoint
 }
 
-func (a *API) boxscoreEndpoint(gameId string) (*url.URL, error) {
-	endpoint := fmt.Sprintf("%s/games/%s/boxscore.xml", a.baseEndpoint(), gameId)
+// gameEndpoint returns the endpoint of a per-game feed such as boxscore or
+// pbp.
+func (a *API) gameEndpoint(gameId string, feed sportsdata.Endpoint) (*url.URL, error) {
+	endpoint := fmt.Sprintf("%s/games/%s/%s.%s", a.baseEndpoint(), gameId, string(feed), string(a.format))
 	u, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, err
@@ -70,13 +202,13 @@ func (a *API) boxscoreEndpoint(gameId string) (*url.URL, error) {
 	q.Set("api_key", a.apiKey)
 	u.RawQuery = q.Encode()
 	if a.log {
-		log.Printf("boxscore endpoint: %+v\n", u.String())
+		log.Printf("%s endpoint: %+v\n", feed, sportsdata.RedactURL(u))
 	}
 	return u, nil
 }
 
-func (a *API) divisionEndpoint() (*url.URL, error) {
-	endpoint := fmt.Sprintf("%s/league/hierarchy.xml", a.baseEndpoint())
+func (a *API) teamProfileEndpoint(teamId string) (*url.URL, error) {
+	endpoint := fmt.Sprintf("%s/teams/%s/profile.%s", a.baseEndpoint(), teamId, string(a.format))
 	u, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, err
@@ -85,13 +217,13 @@ func (a *API) divisionEndpoint() (*url.URL, error) {
 	q.Set("api_key", a.apiKey)
 	u.RawQuery = q.Encode()
 	if a.log {
-		log.Printf("division endpoint: %+v\n", u.String())
+		log.Printf("team profile endpoint: %+v\n", sportsdata.RedactURL(u))
 	}
 	return u, nil
 }
 
-func (a *API) scheduleEndpoint(season string, scheduleType ScheduleType) (*url.URL, error) {
-	endpoint := fmt.Sprintf("%s/games/%s/%s/schedule.xml?", a.baseEndpoint(), season, string(scheduleType))
+func (a *API) dailyScheduleEndpoint(date time.Time) (*url.URL, error) {
+	endpoint := fmt.Sprintf("%s/games/%s/schedule.%s", a.baseEndpoint(), date.Format("2006/01/02"), string(a.format))
 	u, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, err
@@ -100,110 +232,351 @@ func (a *API) scheduleEndpoint(season string, scheduleType ScheduleType) (*url.U
 	q.Set("api_key", a.apiKey)
 	u.RawQuery = q.Encode()
 	if a.log {
-		log.Printf("schedule endpoint: %+v\n", u.String())
+		log.Printf("daily schedule endpoint: %+v\n", sportsdata.RedactURL(u))
 	}
 	return u, nil
 }
 
-func (a *API) League() (*League, error) {
-	endpoint, err := a.divisionEndpoint()
+func (a *API) seasonalStatisticsEndpoint(season string, scheduleType ScheduleType, teamId string) (*url.URL, error) {
+	endpoint := fmt.Sprintf("%s/seasontd/%s/%s/teams/%s/statistics.%s", a.baseEndpoint(), season, string(scheduleType), teamId, string(a.format))
+	u, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, err
 	}
-	time.Sleep(1 * time.Second)
-	resp, err := http.Get(endpoint.String())
+	q := u.Query()
+	q.Set("api_key", a.apiKey)
+	u.RawQuery = q.Encode()
+	if a.log {
+		log.Printf("seasonal statistics endpoint: %+v\n", sportsdata.RedactURL(u))
+	}
+	return u, nil
+}
+
+func (a *API) divisionEndpoint() (*url.URL, error) {
+	endpoint := fmt.Sprintf("%s/league/hierarchy.%s", a.baseEndpoint(), string(a.format))
+	u, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, err
 	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(fmt.Sprintf("API Status Returned Code %d.\nRequest: %+v\nResponse: %+v\n", resp.StatusCode, resp.Request, resp))
+	q := u.Query()
+	q.Set("api_key", a.apiKey)
+	u.RawQuery = q.Encode()
+	if a.log {
+		log.Printf("division endpoint: %+v\n", sportsdata.RedactURL(u))
+	}
+	return u, nil
+}
+
+func (a *API) scheduleEndpoint(season string, scheduleType ScheduleType) (*url.URL, error) {
+	endpoint := fmt.Sprintf("%s/games/%s/%s/schedule.%s", a.baseEndpoint(), season, string(scheduleType), string(a.format))
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	q.Set("api_key", a.apiKey)
+	u.RawQuery = q.Encode()
+	if a.log {
+		log.Printf("schedule endpoint: %+v\n", sportsdata.RedactURL(u))
+	}
+	return u, nil
+}
+
+// fetch requests u, waiting on the rate limiter before every attempt, and
+// calls decode with the response body. If a cache is configured, fresh
+// entries are decoded without a request, stale ones are revalidated and the
+// response is stored for as long as the cache TTL gives for endpoint and v,
+// the value decode fills in.
+func (a *API) fetch(ctx context.Context, endpoint sportsdata.Endpoint, u *url.URL, v interface{}, decode func(io.Reader) error) error {
+	key := sportsdata.RedactURL(u)
+	var cached *sportsdata.CacheEntry
+	if a.cache != nil {
+		if entry, ok := a.cache.Get(key); ok {
+			if entry.Fresh(time.Now()) {
+				return decode(bytes.NewReader(entry.Body))
+			}
+			cached = entry
+		}
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return err
+	}
+	if cached != nil {
+		cached.Revalidate(req)
+	}
+	resp, err := a.retry.Do(ctx, func() (*http.Response, error) {
+		if err := a.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		return a.client.Do(req.WithContext(ctx))
+	})
+	if err != nil {
+		return sportsdata.RedactError(err)
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		if a.log {
+			log.Printf("%s not modified: %+v\n", endpoint, key)
+		}
+		if err := decode(bytes.NewReader(cached.Body)); err != nil {
+			return err
+		}
+		refreshed := *cached
+		refreshed.Refresh(a.cacheTTL(endpoint, v))
+		a.cache.Set(key, &refreshed)
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return sportsdata.NewAPIError(endpoint, resp)
+	}
+	if a.cache == nil {
+		return decode(resp.Body)
+	}
 	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if err := decode(bytes.NewReader(body)); err != nil {
+		return err
+	}
+	if ttl := a.cacheTTL(endpoint, v); ttl > 0 {
+		a.cache.Set(key, sportsdata.NewCacheEntry(resp, body, ttl))
+	}
+	return nil
+}
+
+// get fetches u and decodes the response into v.
+func (a *API) get(ctx context.Context, endpoint sportsdata.Endpoint, u *url.URL, v interface{}) error {
+	return a.fetch(ctx, endpoint, u, v, func(body io.Reader) error {
+		return a.format.Decode(body, v)
+	})
+}
+
+func (a *API) League() (*League, error) {
+	return a.LeagueContext(context.Background())
+}
+
+func (a *API) LeagueContext(ctx context.Context) (*League, error) {
+	endpoint, err := a.divisionEndpoint()
 	if err != nil {
 		return nil, err
 	}
 	league := new(League)
-	err = xml.Unmarshal(body, league)
-	return league, err
+	err = a.get(ctx, sportsdata.EndpointHierarchy, endpoint, league)
+	if err != nil {
+		return nil, err
+	}
+	return league, nil
 }
 
 func (a *API) Schedule(season string, scheduleType ScheduleType) (*Schedule, error) {
+	return a.ScheduleContext(context.Background(), season, scheduleType)
+}
+
+func (a *API) ScheduleContext(ctx context.Context, season string, scheduleType ScheduleType) (*Schedule, error) {
 	endpoint, err := a.scheduleEndpoint(season, scheduleType)
 	if err != nil {
 		return nil, err
 	}
-	time.Sleep(1 * time.Second)
-	resp, err := http.Get(endpoint.String())
+	league := new(League)
+	err = a.get(ctx, sportsdata.EndpointSchedule, endpoint, league)
 	if err != nil {
 		return nil, err
 	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(fmt.Sprintf("API Status Returned Code %d.\nRequest: %+v\nResponse: %+v\n", resp.StatusCode, resp.Request, resp))
-	}
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
+	schedule := new(Schedule)
+	schedule.Season = season
+	schedule.ScheduleType = scheduleType
+	schedule.League = league
+	return schedule, nil
+}
+
+// DailySchedule returns the games scheduled on the calendar date of date in
+// its location.
+func (a *API) DailySchedule(date time.Time) (*DailySchedule, error) {
+	return a.DailyScheduleContext(context.Background(), date)
+}
+
+func (a *API) DailyScheduleContext(ctx context.Context, date time.Time) (*DailySchedule, error) {
+	endpoint, err := a.dailyScheduleEndpoint(date)
 	if err != nil {
 		return nil, err
 	}
 	league := new(League)
-	err = xml.Unmarshal(body, league)
+	err = a.get(ctx, sportsdata.EndpointSchedule, endpoint, league)
 	if err != nil {
 		return nil, err
 	}
-	schedule := new(Schedule)
-	schedule.Season = season
-	schedule.ScheduleType = scheduleType
+	schedule := new(DailySchedule)
+	schedule.Date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
 	schedule.League = league
 	return schedule, nil
 }
 
-func (a *API) AllSchedules(seasons []string) ([]*Schedule, error) {
-	schedules := make([]*Schedule, 0)
-	for _, season := range seasons {
-		for _, scheduleType := range ScheduleAll {
-			schedule, err := a.Schedule(season, scheduleType)
-			if err != nil {
-				return nil, err
+// EachScheduleGame streams the schedule for season and scheduleType, calling
+// fn with every game as it is decoded rather than holding the whole season in
+// memory. Iteration stops at the first error returned by fn.
+func (a *API) EachScheduleGame(season string, scheduleType ScheduleType, fn func(game *Game) error) error {
+	return a.EachScheduleGameContext(context.Background(), season, scheduleType, fn)
+}
+
+func (a *API) EachScheduleGameContext(ctx context.Context, season string, scheduleType ScheduleType, fn func(game *Game) error) error {
+	endpoint, err := a.scheduleEndpoint(season, scheduleType)
+	if err != nil {
+		return err
+	}
+	return a.fetch(ctx, sportsdata.EndpointSchedule, endpoint, nil, func(body io.Reader) error {
+		if a.format != sportsdata.FormatXML {
+			league := new(League)
+			if err := a.format.Decode(body, league); err != nil {
+				return err
 			}
-			schedules = append(schedules, schedule)
+			if league.SeasonSchedule == nil {
+				return nil
+			}
+			for _, g := range league.SeasonSchedule.Games.Games {
+				if err := fn(g); err != nil {
+					return err
+				}
+			}
+			return nil
 		}
+		return sportsdata.EachStartElement(body, func(d *xml.Decoder, start xml.StartElement) error {
+			if start.Name.Local != "game" {
+				return nil
+			}
+			game := new(Game)
+			if err := d.DecodeElement(game, &start); err != nil {
+				return err
+			}
+			return fn(game)
+		})
+	})
+}
+
+// AllSchedules fetches the schedule of every type of each of seasons, ordered
+// by season and then by ScheduleAll. A failed request leaves nil at its
+// index, and the error is a *sportsdata.BatchError.
+func (a *API) AllSchedules(seasons []string) ([]*Schedule, error) {
+	return a.AllSchedulesContext(context.Background(), seasons)
+}
+
+func (a *API) AllSchedulesContext(ctx context.Context, seasons []string) ([]*Schedule, error) {
+	results := a.AllSchedulesResultsContext(ctx, seasons)
+	schedules := make([]*Schedule, len(results))
+	for i, result := range results {
+		schedules[i] = result.Schedule
 	}
-	return schedules, nil
+	return schedules, results.Err()
 }
 
 func (a *API) Boxscore(gameId string) (*Boxscore, error) {
-	endpoint, err := a.boxscoreEndpoint(gameId)
+	return a.BoxscoreContext(context.Background(), gameId)
+}
+
+func (a *API) BoxscoreContext(ctx context.Context, gameId string) (*Boxscore, error) {
+	endpoint, err := a.gameEndpoint(gameId, sportsdata.EndpointBoxscore)
 	if err != nil {
 		return nil, err
 	}
-	time.Sleep(1 * time.Second)
-	resp, err := http.Get(endpoint.String())
+	boxscore := new(Boxscore)
+	err = a.get(ctx, sportsdata.EndpointBoxscore, endpoint, boxscore)
 	if err != nil {
 		return nil, err
 	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(fmt.Sprintf("API Status Returned Code %d.\nRequest: %+v\nResponse: %+v\n", resp.StatusCode, resp.Request, resp))
+	return boxscore, nil
+}
+
+// PlayByPlay returns every event of a game by period.
+func (a *API) PlayByPlay(gameId string) (*PlayByPlay, error) {
+	return a.PlayByPlayContext(context.Background(), gameId)
+}
+
+func (a *API) PlayByPlayContext(ctx context.Context, gameId string) (*PlayByPlay, error) {
+	endpoint, err := a.gameEndpoint(gameId, sportsdata.EndpointPlayByPlay)
+	if err != nil {
+		return nil, err
 	}
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
+	pbp := new(PlayByPlay)
+	err = a.get(ctx, sportsdata.EndpointPlayByPlay, endpoint, pbp)
 	if err != nil {
 		return nil, err
 	}
-	boxscore := new(Boxscore)
-	err = xml.Unmarshal(body, boxscore)
-	return boxscore, err
+	return pbp, nil
+}
+
+// GameSummary returns the boxscore of a game with the stat line of every
+// player.
+func (a *API) GameSummary(gameId string) (*GameSummary, error) {
+	return a.GameSummaryContext(context.Background(), gameId)
+}
+
+func (a *API) GameSummaryContext(ctx context.Context, gameId string) (*GameSummary, error) {
+	endpoint, err := a.gameEndpoint(gameId, sportsdata.EndpointSummary)
+	if err != nil {
+		return nil, err
+	}
+	summary := new(GameSummary)
+	err = a.get(ctx, sportsdata.EndpointSummary, endpoint, summary)
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
 }
 
+// TeamProfile returns the coaches and current roster of the team with
+// teamId, as listed by League.Teams.
+func (a *API) TeamProfile(teamId string) (*TeamProfile, error) {
+	return a.TeamProfileContext(context.Background(), teamId)
+}
+
+func (a *API) TeamProfileContext(ctx context.Context, teamId string) (*TeamProfile, error) {
+	endpoint, err := a.teamProfileEndpoint(teamId)
+	if err != nil {
+		return nil, err
+	}
+	profile := new(TeamProfile)
+	err = a.get(ctx, sportsdata.EndpointProfile, endpoint, profile)
+	if err != nil {
+		return nil, err
+	}
+	profile.setTeamIds()
+	return profile, nil
+}
+
+// SeasonalStatistics returns the season to date totals and averages of the
+// team with teamId and its players.
+func (a *API) SeasonalStatistics(season string, scheduleType ScheduleType, teamId string) (*SeasonalStatistics, error) {
+	return a.SeasonalStatisticsContext(context.Background(), season, scheduleType, teamId)
+}
+
+func (a *API) SeasonalStatisticsContext(ctx context.Context, season string, scheduleType ScheduleType, teamId string) (*SeasonalStatistics, error) {
+	endpoint, err := a.seasonalStatisticsEndpoint(season, scheduleType, teamId)
+	if err != nil {
+		return nil, err
+	}
+	statistics := new(SeasonalStatistics)
+	err = a.get(ctx, sportsdata.EndpointStatistics, endpoint, statistics)
+	if err != nil {
+		return nil, err
+	}
+	statistics.Season = season
+	statistics.ScheduleType = scheduleType
+	return statistics, nil
+}
+
+// Boxscores fetches the boxscore of each game in ids, in order. A failed
+// request leaves nil at its index, and the error is a
+// *sportsdata.BatchError.
 func (a *API) Boxscores(ids []string) ([]*Boxscore, error) {
-	boxscores := make([]*Boxscore, 0)
-	for _, id := range ids {
-		fmt.Printf("Getting boxscore for %s\n", id)
-		boxscore, err := a.Boxscore(id)
-		if err != nil {
-			return nil, err
-		}
-		boxscores = append(boxscores, boxscore)
+	return a.BoxscoresContext(context.Background(), ids)
+}
+
+func (a *API) BoxscoresContext(ctx context.Context, ids []string) ([]*Boxscore, error) {
+	results := a.BoxscoresResultsContext(ctx, ids)
+	boxscores := make([]*Boxscore, len(results))
+	for i, result := range results {
+		boxscores[i] = result.Boxscore
 	}
-	return boxscores, nil
+	return boxscores, results.Err()
 }
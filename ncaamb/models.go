@@ -1,37 +1,39 @@
 package ncaamb
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"github.com/tassl-app/sportsdata"
 	"time"
 )
 
 type Team struct {
-	Id           string            `xml:"id,attr"`
-	ConferenceId string            `xml:"-"`
-	Name         string            `xml:"name,attr"`
-	Market       string            `xml:"market,attr"`
-	Alias        string            `xml:"alias,attr"`
-	Venue        *sportsdata.Venue `xml:"venue"`
+	Id           string            `xml:"id,attr" json:"id"`
+	ConferenceId string            `xml:"-" json:"-"`
+	Name         string            `xml:"name,attr" json:"name"`
+	Market       string            `xml:"market,attr" json:"market"`
+	Alias        string            `xml:"alias,attr" json:"alias"`
+	Venue        *sportsdata.Venue `xml:"venue" json:"venue"`
 }
 
 type Conference struct {
-	Id    string  `xml:"id,attr"`
-	Name  string  `xml:"name,attr"`
-	Alias string  `xml:"alias,attr"`
-	Teams []*Team `xml:"team"`
+	Id    string  `xml:"id,attr" json:"id"`
+	Name  string  `xml:"name,attr" json:"name"`
+	Alias string  `xml:"alias,attr" json:"alias"`
+	Teams []*Team `xml:"team" json:"teams"`
 }
 
 type Division struct {
-	Id          string        `xml:"id,attr"`
-	Name        string        `xml:"name,attr"`
-	Alias       string        `xml:"alias,attr"`
-	Conferences []*Conference `xml:"conference"`
+	Id          string        `xml:"id,attr" json:"id"`
+	Name        string        `xml:"name,attr" json:"name"`
+	Alias       string        `xml:"alias,attr" json:"alias"`
+	Conferences []*Conference `xml:"conference" json:"conferences"`
 }
 
 type HomeTeam struct {
-	Id    string `xml:"id,attr"`
-	Name  string `xml:"name,attr"`
-	Alias string `xml:"alias,attr"`
+	Id    string `xml:"id,attr" json:"id"`
+	Name  string `xml:"name,attr" json:"name"`
+	Alias string `xml:"alias,attr" json:"alias"`
 }
 
 func (t *HomeTeam) Team() *Team {
@@ -43,9 +45,9 @@ func (t *HomeTeam) Team() *Team {
 }
 
 type AwayTeam struct {
-	Id    string `xml:"id,attr"`
-	Name  string `xml:"name,attr"`
-	Alias string `xml:"alias,attr"`
+	Id    string `xml:"id,attr" json:"id"`
+	Name  string `xml:"name,attr" json:"name"`
+	Alias string `xml:"alias,attr" json:"alias"`
 }
 
 func (t *AwayTeam) Team() *Team {
@@ -57,14 +59,29 @@ func (t *AwayTeam) Team() *Team {
 }
 
 type Game struct {
-	Id         string    `xml:"id,attr"`
-	Status     string    `xml:"status,attr"`
-	Coverage   string    `xml:"coverage,attr"`
-	HomeTeamId string    `xml:"home_team,attr"`
-	AwayTeamId string    `xml:"away_team,attr"`
-	Scheduled  string    `xml:"scheduled,attr"`
-	HomeTeam   *HomeTeam `xml:"home"`
-	AwayTeam   *AwayTeam `xml:"away"`
+	Id         string    `xml:"id,attr" json:"id"`
+	Status     string    `xml:"status,attr" json:"status"`
+	Coverage   string    `xml:"coverage,attr" json:"coverage"`
+	HomeTeamId string    `xml:"home_team,attr" json:"home_team"`
+	AwayTeamId string    `xml:"away_team,attr" json:"away_team"`
+	Scheduled  string    `xml:"scheduled,attr" json:"scheduled"`
+	HomeTeam   *HomeTeam `xml:"home" json:"home"`
+	AwayTeam   *AwayTeam `xml:"away" json:"away"`
+}
+
+// JSON feeds identify the teams only within home and away.
+func (g *Game) UnmarshalJSON(data []byte) error {
+	type game Game
+	if err := json.Unmarshal(data, (*game)(g)); err != nil {
+		return err
+	}
+	if g.HomeTeamId == "" && g.HomeTeam != nil {
+		g.HomeTeamId = g.HomeTeam.Id
+	}
+	if g.AwayTeamId == "" && g.AwayTeam != nil {
+		g.AwayTeamId = g.AwayTeam.Id
+	}
+	return nil
 }
 
 func (g *Game) FormattedScheduled() (time.Time, error) {
@@ -76,23 +93,99 @@ func (g *Game) ParseScheduled(t time.Time) string {
 }
 
 type Games struct {
-	Games []*Game `xml:"game"`
+	Games []*Game `xml:"game" json:"games"`
+}
+
+// JSON feeds list games directly rather than inside a games element.
+func (g Games) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.Games)
+}
+
+func (g *Games) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &g.Games)
 }
 
 type SeasonSchedule struct {
-	Id         string `xml:"id,attr"`
-	Year       string `xml:"year,attr"`
-	SeasonType string `xml:"type,attr"`
-	Games      Games  `xml:"games"`
+	Id         string `xml:"id,attr" json:"id"`
+	Year       string `xml:"year,attr" json:"year"`
+	SeasonType string `xml:"type,attr" json:"type"`
+	Games      Games  `xml:"games" json:"-"`
+}
+
+// JSON feeds give the year as a number.
+func (s *SeasonSchedule) UnmarshalJSON(data []byte) error {
+	type seasonSchedule SeasonSchedule
+	v := struct {
+		*seasonSchedule
+		Year json.Number `json:"year"`
+	}{seasonSchedule: (*seasonSchedule)(s)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	s.Year = v.Year.String()
+	return nil
+}
+
+// LeagueDailySchedule lists the games of a single date.
+type LeagueDailySchedule struct {
+	Date  string `xml:"date,attr" json:"-"`
+	Games Games  `xml:"games" json:"-"`
 }
 
 type League struct {
-	XMLNS          string          `xml:"xmlns,attr"`
-	Id             string          `xml:"id,attr"`
-	Name           string          `xml:"name,attr"`
-	Alias          string          `xml:"alias,attr"`
-	Divisions      []*Division     `xml:"division"`
-	SeasonSchedule *SeasonSchedule `xml:"season-schedule"`
+	XMLNS          string               `xml:"xmlns,attr" json:"-"`
+	Id             string               `xml:"id,attr" json:"id"`
+	Name           string               `xml:"name,attr" json:"name"`
+	Alias          string               `xml:"alias,attr" json:"alias"`
+	Divisions      []*Division          `xml:"division" json:"divisions"`
+	SeasonSchedule *SeasonSchedule      `xml:"season-schedule" json:"season_schedule"`
+	DailySchedule  *LeagueDailySchedule `xml:"daily-schedule" json:"daily_schedule"`
+}
+
+// leagueJSON is the shape of the JSON feeds, which give the league beside
+// its divisions, season and games rather than around them.
+type leagueJSON struct {
+	League struct {
+		Id    string `json:"id"`
+		Name  string `json:"name"`
+		Alias string `json:"alias"`
+	} `json:"league"`
+	Divisions []*Division     `json:"divisions,omitempty"`
+	Season    *SeasonSchedule `json:"season,omitempty"`
+	Date      string          `json:"date,omitempty"`
+	Games     *Games          `json:"games,omitempty"`
+}
+
+func (l *League) MarshalJSON() ([]byte, error) {
+	v := leagueJSON{Divisions: l.Divisions, Season: l.SeasonSchedule}
+	v.League.Id, v.League.Name, v.League.Alias = l.Id, l.Name, l.Alias
+	if l.SeasonSchedule != nil {
+		v.Games = &l.SeasonSchedule.Games
+	} else if l.DailySchedule != nil {
+		v.Date = l.DailySchedule.Date
+		v.Games = &l.DailySchedule.Games
+	}
+	return json.Marshal(v)
+}
+
+func (l *League) UnmarshalJSON(data []byte) error {
+	var v leagueJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	l.Id, l.Name, l.Alias = v.League.Id, v.League.Name, v.League.Alias
+	l.Divisions = v.Divisions
+	l.SeasonSchedule, l.DailySchedule = v.Season, nil
+	switch {
+	case v.Season != nil && v.Games != nil:
+		v.Season.Games = *v.Games
+	case v.Date != "" || v.Games != nil:
+		l.DailySchedule = &LeagueDailySchedule{Date: v.Date}
+		if v.Games != nil {
+			l.DailySchedule.Games = *v.Games
+		}
+	}
+	return nil
 }
 
 func (l *League) Teams() []*Team {
@@ -132,6 +225,20 @@ func (s *Schedule) Games() []*Game {
 	return s.League.SeasonSchedule.Games.Games
 }
 
+// DailySchedule is the schedule of a single date, as returned by
+// API.DailySchedule.
+type DailySchedule struct {
+	Date   time.Time
+	League *League
+}
+
+func (s *DailySchedule) Games() []*Game {
+	if s.League.DailySchedule == nil {
+		return make([]*Game, 0)
+	}
+	return s.League.DailySchedule.Games.Games
+}
+
 func (s *Schedule) FilterGames(l []*Game) []*Game {
 	filtered := make([]*Game, 0)
 	for _, g := range l {
@@ -159,18 +266,23 @@ func (s *Schedule) FilterBoxscores(l []*Boxscore) []*Boxscore {
 }
 
 type Boxscore struct {
-	XMLNS       string          `xml:"xmlns,attr"`
-	Id          string          `xml:"id,attr"`
-	Status      string          `xml:"status,attr"`
-	Coverage    string          `xml:"coverage,attr"`
-	HomeTeamId  string          `xml:"home_team,attr"`
-	AwayTeamId  string          `xml:"away_team,attr"`
-	Scheduled   string          `xml:"scheduled,attr"`
-	Attendance  int64           `xml:"attendance,attr"`
-	LeadChanges int64           `xml:"lead_chages,attr"`
-	TimesTied   int64           `xml:"times_tied,attr"`
-	Half        int64           `xml:"half"`
-	Teams       []*BoxscoreTeam `xml:"team"`
+	XMLNS       string          `xml:"xmlns,attr" json:"-"`
+	Id          string          `xml:"id,attr" json:"id"`
+	Status      string          `xml:"status,attr" json:"status"`
+	Coverage    string          `xml:"coverage,attr" json:"coverage"`
+	HomeTeamId  string          `xml:"home_team,attr" json:"home_team"`
+	AwayTeamId  string          `xml:"away_team,attr" json:"away_team"`
+	Scheduled   string          `xml:"scheduled,attr" json:"scheduled"`
+	Attendance  int64           `xml:"attendance,attr" json:"attendance"`
+	LeadChanges int64           `xml:"lead_changes,attr" json:"lead_changes"`
+	TimesTied   int64           `xml:"times_tied,attr" json:"times_tied"`
+	Half        int64           `xml:"half" json:"half"`
+	Teams       []*BoxscoreTeam `xml:"team" json:"teams"`
+}
+
+// Closed reports whether the game is final and its statistics verified.
+func (b *Boxscore) Closed() bool {
+	return b.Status == "closed"
 }
 
 func (b *Boxscore) FormattedScheduled() (time.Time, error) {
@@ -212,72 +324,658 @@ func (b *Boxscore) AwayTeamScore() (int64, error) {
 }
 
 type BoxscoreTeam struct {
-	Name            string           `xml:"name,attr"`
-	Market          string           `xml:"market,attr"`
-	Id              string           `xml:"id,attr"`
-	Points          int64            `xml:"points,attr"`
-	Rank            int64            `xml:"rank,attr"`
-	BoxscoreScoring *BoxscoreScoring `xml:"scoring"`
-	Leaders         *BoxscoreLeader  `xml:"leaders"`
+	Name            string           `xml:"name,attr" json:"name"`
+	Market          string           `xml:"market,attr" json:"market"`
+	Id              string           `xml:"id,attr" json:"id"`
+	Points          int64            `xml:"points,attr" json:"points"`
+	Rank            int64            `xml:"rank,attr" json:"rank"`
+	BoxscoreScoring *BoxscoreScoring `xml:"scoring" json:"scoring"`
+	Leaders         *BoxscoreLeader  `xml:"leaders" json:"leaders"`
 }
 
 type BoxscoreScoring struct {
-	Halves []*BoxcoreScoringHalf `xml:"half"`
+	Halves []*BoxcoreScoringHalf `xml:"half" json:"halves"`
 }
 
 type BoxcoreScoringHalf struct {
-	Number   int64 `xml:"number,attr"`
-	Sequence int64 `xml:"sequence,attr"`
-	Points   int64 `xml:"points,attr"`
+	Number   int64 `xml:"number,attr" json:"number"`
+	Sequence int64 `xml:"sequence,attr" json:"sequence"`
+	Points   int64 `xml:"points,attr" json:"points"`
 }
 
+// BoxscoreLeader lists the leaders of a team in points, rebounds and
+// assists. Every category lists all the players tied for the lead.
 type BoxscoreLeader struct {
-	Points *BoxscoreLeaderPoint `xml:"points"`
-	// TODO
-	// BoxscoreLeaderRebound
-	// BoxscoreLeaderAssist
+	Points   *BoxscoreLeaderPoint   `xml:"points" json:"points"`
+	Rebounds *BoxscoreLeaderRebound `xml:"rebounds" json:"rebounds"`
+	Assists  *BoxscoreLeaderAssist  `xml:"assists" json:"assists"`
 }
 
 type BoxscoreLeaderPoint struct {
-	Player *BoxscoreLeaderPointPlayer `xml:"player"`
-}
-
-type BoxscoreLeaderPointPlayer struct {
-	FullName    string                               `xml:"full_name"`
-	Position    string                               `xml:"position"`
-	JersyNumber string                               `xml:"jersey_number"`
-	Id          string                               `xml:"id"`
-	Statistics  *BoxscoreLeaderPointPlayerStatistics `xml:"statistics"`
-}
-
-type BoxscoreLeaderPointPlayerStatistics struct {
-	Minutes              string `xml:"minutes"`
-	FieldGoalsMade       string `xml:"field_goals_made"`
-	FieldGoalsAtt        string `xml:"field_goals_att"`
-	ThreePointsMade      string `xml:"three_points_made"`
-	ThreePointsAttempted string `xml:"three_points_att"`
-	ThreePointsPercent   string `xml:"three_points_pct"`
-	TwoPointsMade        string `xml:"two_points_made"`
-	TwoPointsAttempted   string `xml:"two_points_attempted"`
-	TwoPointsPercent     string `xml:"two_points_pct"`
-	FreeThrowsMade       string `xml:"free_throws_made"`
-	FreeThrowsAttempted  string `xml:"free_throws_att"`
-	FreeThrowsPercent    string `xml:"free_throws_pct"`
-	OffensiveRebounds    string `xml:"offensive_rebounds"`
-	DefensiveRebounds    string `xml:"defensive_rebounds"`
-	Rebounds             string `xml:"rebounds"`
-	Assists              string `xml:"assists"`
-	Turnovers            string `xml:"turnovers"`
-	Steals               string `xml:"steals"`
-	Blocks               string `xml:"blocks"`
-	AssistsTurnoverRatio string `xml:"assists_turnover_ratio"`
-	PersonalFouls        string `xml:"personal_fouls"`
-	TechFouls            string `xml:"tech_fouls"`
-	Points               string `xml:"points"`
-}
-
-// TODO
-type BoxscoreLeaderRebound struct{}
-
-// TODO
-type BoxscoreLeaderAssist struct{}
+	// Player is the first of Players.
+	Player  *BoxscoreLeaderPlayer   `xml:"-" json:"-"`
+	Players []*BoxscoreLeaderPlayer `xml:"player" json:"players"`
+}
+
+func (l *BoxscoreLeaderPoint) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type leader BoxscoreLeaderPoint
+	if err := d.DecodeElement((*leader)(l), &start); err != nil {
+		return err
+	}
+	l.Player = firstLeader(l.Players)
+	return nil
+}
+
+func (l *BoxscoreLeaderPoint) UnmarshalJSON(data []byte) error {
+	type leader BoxscoreLeaderPoint
+	if err := json.Unmarshal(data, (*leader)(l)); err != nil {
+		return err
+	}
+	l.Player = firstLeader(l.Players)
+	return nil
+}
+
+type BoxscoreLeaderRebound struct {
+	// Player is the first of Players.
+	Player  *BoxscoreLeaderPlayer   `xml:"-" json:"-"`
+	Players []*BoxscoreLeaderPlayer `xml:"player" json:"players"`
+}
+
+func (l *BoxscoreLeaderRebound) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type leader BoxscoreLeaderRebound
+	if err := d.DecodeElement((*leader)(l), &start); err != nil {
+		return err
+	}
+	l.Player = firstLeader(l.Players)
+	return nil
+}
+
+func (l *BoxscoreLeaderRebound) UnmarshalJSON(data []byte) error {
+	type leader BoxscoreLeaderRebound
+	if err := json.Unmarshal(data, (*leader)(l)); err != nil {
+		return err
+	}
+	l.Player = firstLeader(l.Players)
+	return nil
+}
+
+type BoxscoreLeaderAssist struct {
+	// Player is the first of Players.
+	Player  *BoxscoreLeaderPlayer   `xml:"-" json:"-"`
+	Players []*BoxscoreLeaderPlayer `xml:"player" json:"players"`
+}
+
+func (l *BoxscoreLeaderAssist) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type leader BoxscoreLeaderAssist
+	if err := d.DecodeElement((*leader)(l), &start); err != nil {
+		return err
+	}
+	l.Player = firstLeader(l.Players)
+	return nil
+}
+
+func (l *BoxscoreLeaderAssist) UnmarshalJSON(data []byte) error {
+	type leader BoxscoreLeaderAssist
+	if err := json.Unmarshal(data, (*leader)(l)); err != nil {
+		return err
+	}
+	l.Player = firstLeader(l.Players)
+	return nil
+}
+
+func firstLeader(players []*BoxscoreLeaderPlayer) *BoxscoreLeaderPlayer {
+	if len(players) == 0 {
+		return nil
+	}
+	return players[0]
+}
+
+// BoxscoreLeaderPointPlayer is the former name of BoxscoreLeaderPlayer.
+type BoxscoreLeaderPointPlayer = BoxscoreLeaderPlayer
+
+type BoxscoreLeaderPlayer struct {
+	FullName    string              `xml:"full_name,attr" json:"full_name"`
+	Position    string              `xml:"position,attr" json:"position"`
+	JersyNumber string              `xml:"jersey_number,attr" json:"jersey_number"`
+	Id          string              `xml:"id,attr" json:"id"`
+	Statistics  *BoxscoreStatistics `xml:"statistics" json:"statistics"`
+}
+
+// BoxscoreLeaderPointPlayerStatistics is the former name of
+// BoxscoreStatistics.
+type BoxscoreLeaderPointPlayerStatistics = BoxscoreStatistics
+
+// BoxscoreStatistics is the stat line of a player or the totals of a team.
+// Blank statistics decode as zero, or nil for percentages.
+type BoxscoreStatistics struct {
+	Minutes              time.Duration
+	FieldGoalsMade       int64
+	FieldGoalsAtt        int64
+	FieldGoalsPercent    *float64
+	ThreePointsMade      int64
+	ThreePointsAttempted int64
+	ThreePointsPercent   *float64
+	TwoPointsMade        int64
+	TwoPointsAttempted   int64
+	TwoPointsPercent     *float64
+	FreeThrowsMade       int64
+	FreeThrowsAttempted  int64
+	FreeThrowsPercent    *float64
+	OffensiveRebounds    int64
+	DefensiveRebounds    int64
+	Rebounds             int64
+	Assists              int64
+	Turnovers            int64
+	Steals               int64
+	Blocks               int64
+	AssistsTurnoverRatio float64
+	PersonalFouls        int64
+	TechFouls            int64
+	FlagrantFouls        int64
+	Points               int64
+	PlusMinus            int64
+	// TeamRebounds and TeamTurnovers are only given in team totals.
+	TeamRebounds  int64
+	TeamTurnovers int64
+}
+
+func (s *BoxscoreStatistics) stats() []sportsdata.Stat {
+	return []sportsdata.Stat{
+		sportsdata.MinutesStat("minutes", &s.Minutes),
+		sportsdata.IntStat("field_goals_made", &s.FieldGoalsMade),
+		sportsdata.IntStat("field_goals_att", &s.FieldGoalsAtt),
+		sportsdata.PercentStat("field_goals_pct", &s.FieldGoalsPercent),
+		sportsdata.IntStat("three_points_made", &s.ThreePointsMade),
+		sportsdata.IntStat("three_points_att", &s.ThreePointsAttempted),
+		sportsdata.PercentStat("three_points_pct", &s.ThreePointsPercent),
+		sportsdata.IntStat("two_points_made", &s.TwoPointsMade),
+		sportsdata.IntStat("two_points_att", &s.TwoPointsAttempted),
+		sportsdata.PercentStat("two_points_pct", &s.TwoPointsPercent),
+		sportsdata.IntStat("free_throws_made", &s.FreeThrowsMade),
+		sportsdata.IntStat("free_throws_att", &s.FreeThrowsAttempted),
+		sportsdata.PercentStat("free_throws_pct", &s.FreeThrowsPercent),
+		sportsdata.IntStat("offensive_rebounds", &s.OffensiveRebounds),
+		sportsdata.IntStat("defensive_rebounds", &s.DefensiveRebounds),
+		sportsdata.IntStat("rebounds", &s.Rebounds),
+		sportsdata.IntStat("assists", &s.Assists),
+		sportsdata.IntStat("turnovers", &s.Turnovers),
+		sportsdata.IntStat("steals", &s.Steals),
+		sportsdata.IntStat("blocks", &s.Blocks),
+		sportsdata.FloatStat("assists_turnover_ratio", &s.AssistsTurnoverRatio),
+		sportsdata.IntStat("personal_fouls", &s.PersonalFouls),
+		sportsdata.IntStat("tech_fouls", &s.TechFouls),
+		sportsdata.IntStat("flagrant_fouls", &s.FlagrantFouls),
+		sportsdata.IntStat("points", &s.Points),
+		sportsdata.IntStat("plus_minus", &s.PlusMinus),
+		sportsdata.IntStat("team_rebounds", &s.TeamRebounds),
+		sportsdata.IntStat("team_turnovers", &s.TeamTurnovers),
+	}
+}
+
+func (s *BoxscoreStatistics) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return sportsdata.UnmarshalStatsXML(d, start, s.stats())
+}
+
+func (s *BoxscoreStatistics) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXML(e, start, s.stats())
+}
+
+func (s *BoxscoreStatistics) UnmarshalJSON(data []byte) error {
+	return sportsdata.UnmarshalStatsJSON(data, s.stats())
+}
+
+func (s *BoxscoreStatistics) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSON(s.stats())
+}
+
+// PlayByPlay is the play-by-play feed of a game.
+type PlayByPlay struct {
+	XMLNS      string              `xml:"xmlns,attr" json:"-"`
+	Id         string              `xml:"id,attr" json:"id"`
+	Status     string              `xml:"status,attr" json:"status"`
+	Coverage   string              `xml:"coverage,attr" json:"coverage"`
+	HomeTeamId string              `xml:"home_team,attr" json:"home_team"`
+	AwayTeamId string              `xml:"away_team,attr" json:"away_team"`
+	Scheduled  string              `xml:"scheduled,attr" json:"scheduled"`
+	Venue      *sportsdata.Venue   `xml:"venue" json:"venue"`
+	Teams      []*EventTeam        `xml:"team" json:"teams"`
+	Periods    []*PlayByPlayPeriod `xml:"-" json:"periods"`
+}
+
+// playByPlayPeriod is a child element of the play-by-play feed that may be
+// a period.
+type playByPlayPeriod struct {
+	XMLName xml.Name
+	PlayByPlayPeriod
+}
+
+// UnmarshalXML decodes the half and overtime elements of the feed, in order,
+// as Periods.
+func (p *PlayByPlay) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type playByPlay PlayByPlay
+	v := struct {
+		*playByPlay
+		Elements []*playByPlayPeriod `xml:",any"`
+	}{playByPlay: (*playByPlay)(p)}
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	p.Periods = make([]*PlayByPlayPeriod, 0)
+	for _, e := range v.Elements {
+		switch e.XMLName.Local {
+		case PeriodHalf, PeriodOvertime:
+			e.PlayByPlayPeriod.Type = e.XMLName.Local
+			p.Periods = append(p.Periods, &e.PlayByPlayPeriod)
+		}
+	}
+	return nil
+}
+
+func (p *PlayByPlay) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type playByPlay PlayByPlay
+	return e.EncodeElement(struct {
+		*playByPlay
+		Periods []*PlayByPlayPeriod `xml:"period"`
+	}{(*playByPlay)(p), p.Periods}, start)
+}
+
+// Closed reports whether the game is final and its statistics verified.
+func (p *PlayByPlay) Closed() bool {
+	return p.Status == "closed"
+}
+
+// Events returns the events of every period in order.
+func (p *PlayByPlay) Events() []*Event {
+	events := make([]*Event, 0)
+	for _, period := range p.Periods {
+		events = append(events, period.Events...)
+	}
+	return events
+}
+
+// Period types, the element names of periods in the play-by-play feed.
+const (
+	PeriodHalf     = "half"
+	PeriodOvertime = "overtime"
+)
+
+// PlayByPlayPeriod is a half or an overtime period. Type is PeriodHalf or
+// PeriodOvertime.
+type PlayByPlayPeriod struct {
+	Type     string   `xml:"-" json:"type"`
+	Number   int64    `xml:"number,attr" json:"number"`
+	Sequence int64    `xml:"sequence,attr" json:"sequence"`
+	Events   []*Event `xml:"events>event" json:"events"`
+}
+
+func (p *PlayByPlayPeriod) Overtime() bool {
+	return p.Type == PeriodOvertime
+}
+
+// MarshalXML encodes the period as an element named by its Type.
+func (p *PlayByPlayPeriod) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type playByPlayPeriod PlayByPlayPeriod
+	start.Name.Local = p.Type
+	if start.Name.Local == "" {
+		start.Name.Local = PeriodHalf
+	}
+	return e.EncodeElement((*playByPlayPeriod)(p), start)
+}
+
+// Event is a single play, such as a made shot, a foul or a timeout.
+// HomePoints and AwayPoints are the score after the event.
+type Event struct {
+	Id          string           `xml:"id,attr" json:"id"`
+	Clock       string           `xml:"clock,attr" json:"clock"`
+	EventType   string           `xml:"event_type,attr" json:"event_type"`
+	Updated     string           `xml:"updated,attr" json:"updated"`
+	HomePoints  int64            `xml:"home_points,attr" json:"home_points"`
+	AwayPoints  int64            `xml:"away_points,attr" json:"away_points"`
+	Team        *EventTeam       `xml:"attribution" json:"attribution"`
+	Location    *EventLocation   `xml:"location" json:"location"`
+	Description string           `xml:"description" json:"description"`
+	Statistics  *EventStatistics `xml:"statistics" json:"statistics"`
+}
+
+// Players returns every player credited in the event.
+func (e *Event) Players() []*EventPlayer {
+	players := make([]*EventPlayer, 0)
+	if e.Statistics == nil {
+		return players
+	}
+	for _, s := range e.Statistics.All() {
+		if s.Player != nil {
+			players = append(players, s.Player)
+		}
+	}
+	return players
+}
+
+// Shot returns the field goal attempt of the event, or nil if it has none.
+func (e *Event) Shot() *EventStatistic {
+	if e.Statistics == nil || len(e.Statistics.FieldGoals) == 0 {
+		return nil
+	}
+	return e.Statistics.FieldGoals[0]
+}
+
+type EventTeam struct {
+	Id     string `xml:"id,attr" json:"id"`
+	Name   string `xml:"name,attr" json:"name"`
+	Market string `xml:"market,attr" json:"market"`
+}
+
+type EventPlayer struct {
+	Id           string `xml:"id,attr" json:"id"`
+	FullName     string `xml:"full_name,attr" json:"full_name"`
+	JerseyNumber string `xml:"jersey_number,attr" json:"jersey_number"`
+}
+
+// EventLocation is where a shot was taken, in inches from the corner of the
+// court, which is 1128 by 600.
+type EventLocation struct {
+	X int64 `xml:"coord_x,attr" json:"coord_x"`
+	Y int64 `xml:"coord_y,attr" json:"coord_y"`
+}
+
+// EventStatistics credits the players and teams involved in an event.
+type EventStatistics struct {
+	FieldGoals     []*EventStatistic `xml:"fieldgoal" json:"fieldgoals"`
+	FreeThrows     []*EventStatistic `xml:"freethrow" json:"freethrows"`
+	Assists        []*EventStatistic `xml:"assist" json:"assists"`
+	Rebounds       []*EventStatistic `xml:"rebound" json:"rebounds"`
+	Blocks         []*EventStatistic `xml:"block" json:"blocks"`
+	Steals         []*EventStatistic `xml:"steal" json:"steals"`
+	Turnovers      []*EventStatistic `xml:"turnover" json:"turnovers"`
+	PersonalFouls  []*EventStatistic `xml:"personalfoul" json:"personalfouls"`
+	TechnicalFouls []*EventStatistic `xml:"technicalfoul" json:"technicalfouls"`
+}
+
+// All returns every statistic of the event.
+func (s *EventStatistics) All() []*EventStatistic {
+	all := make([]*EventStatistic, 0)
+	for _, l := range [][]*EventStatistic{s.FieldGoals, s.FreeThrows, s.Assists, s.Rebounds, s.Blocks, s.Steals, s.Turnovers, s.PersonalFouls, s.TechnicalFouls} {
+		all = append(all, l...)
+	}
+	return all
+}
+
+// EventStatistic is a statistic credited to a player or team. Made,
+// ShotType, Points and ThreePointShot apply to shots, and Type to rebounds
+// and turnovers.
+type EventStatistic struct {
+	Type           string       `xml:"type,attr" json:"type"`
+	Made           bool         `xml:"made,attr" json:"made"`
+	ShotType       string       `xml:"shot_type,attr" json:"shot_type"`
+	Points         int64        `xml:"points,attr" json:"points"`
+	ThreePointShot bool         `xml:"three_point_shot,attr" json:"three_point_shot"`
+	Team           *EventTeam   `xml:"team" json:"team"`
+	Player         *EventPlayer `xml:"player" json:"player"`
+}
+
+// GameSummary is the summary feed of a game, with the stat line of every
+// player and the totals of each team.
+type GameSummary struct {
+	XMLNS       string             `xml:"xmlns,attr" json:"-"`
+	Id          string             `xml:"id,attr" json:"id"`
+	Status      string             `xml:"status,attr" json:"status"`
+	Coverage    string             `xml:"coverage,attr" json:"coverage"`
+	HomeTeamId  string             `xml:"home_team,attr" json:"home_team"`
+	AwayTeamId  string             `xml:"away_team,attr" json:"away_team"`
+	Scheduled   string             `xml:"scheduled,attr" json:"scheduled"`
+	Attendance  int64              `xml:"attendance,attr" json:"attendance"`
+	LeadChanges int64              `xml:"lead_changes,attr" json:"lead_changes"`
+	TimesTied   int64              `xml:"times_tied,attr" json:"times_tied"`
+	Clock       string             `xml:"clock,attr" json:"clock"`
+	Half        int64              `xml:"half,attr" json:"half"`
+	Venue       *sportsdata.Venue  `xml:"venue" json:"venue"`
+	Teams       []*GameSummaryTeam `xml:"team" json:"teams"`
+}
+
+// Closed reports whether the game is final and its statistics verified.
+func (s *GameSummary) Closed() bool {
+	return s.Status == "closed"
+}
+
+func (s *GameSummary) FormattedScheduled() (time.Time, error) {
+	return time.Parse(sportsdata.SportsDataTimeFormat, s.Scheduled)
+}
+
+func (s *GameSummary) HomeTeam() *GameSummaryTeam {
+	for _, t := range s.Teams {
+		if t.Id == s.HomeTeamId {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s *GameSummary) AwayTeam() *GameSummaryTeam {
+	for _, t := range s.Teams {
+		if t.Id == s.AwayTeamId {
+			return t
+		}
+	}
+	return nil
+}
+
+type GameSummaryTeam struct {
+	Name       string               `xml:"name,attr" json:"name"`
+	Market     string               `xml:"market,attr" json:"market"`
+	Id         string               `xml:"id,attr" json:"id"`
+	Points     int64                `xml:"points,attr" json:"points"`
+	Rank       int64                `xml:"rank,attr" json:"rank"`
+	Scoring    *BoxscoreScoring     `xml:"scoring" json:"scoring"`
+	Statistics *BoxscoreStatistics  `xml:"statistics" json:"statistics"`
+	Players    []*GameSummaryPlayer `xml:"players>player" json:"players"`
+}
+
+// Starters returns the players who started the game.
+func (t *GameSummaryTeam) Starters() []*GameSummaryPlayer {
+	starters := make([]*GameSummaryPlayer, 0)
+	for _, p := range t.Players {
+		if p.Starter {
+			starters = append(starters, p)
+		}
+	}
+	return starters
+}
+
+// GameSummaryPlayer is a player on a team's game roster. Players who did not
+// play have NotPlayingReason set, such as "Injury", and no statistics.
+type GameSummaryPlayer struct {
+	Id                    string              `xml:"id,attr" json:"id"`
+	FullName              string              `xml:"full_name,attr" json:"full_name"`
+	JerseyNumber          string              `xml:"jersey_number,attr" json:"jersey_number"`
+	Position              string              `xml:"position,attr" json:"position"`
+	PrimaryPosition       string              `xml:"primary_position,attr" json:"primary_position"`
+	Played                bool                `xml:"played,attr" json:"played"`
+	Active                bool                `xml:"active,attr" json:"active"`
+	Starter               bool                `xml:"starter,attr" json:"starter"`
+	NotPlayingReason      string              `xml:"not_playing_reason,attr" json:"not_playing_reason"`
+	NotPlayingDescription string              `xml:"not_playing_description,attr" json:"not_playing_description"`
+	Statistics            *BoxscoreStatistics `xml:"statistics" json:"statistics"`
+}
+
+// TeamProfile is the profile feed of a team, with its coaches and roster.
+type TeamProfile struct {
+	XMLNS   string            `xml:"xmlns,attr" json:"-"`
+	Id      string            `xml:"id,attr" json:"id"`
+	Name    string            `xml:"name,attr" json:"name"`
+	Market  string            `xml:"market,attr" json:"market"`
+	Alias   string            `xml:"alias,attr" json:"alias"`
+	Founded int64             `xml:"founded,attr" json:"founded"`
+	Mascot  string            `xml:"mascot,attr" json:"mascot"`
+	Venue   *sportsdata.Venue `xml:"venue" json:"venue"`
+	Colors  []*TeamColor      `xml:"team_colors>team_color" json:"team_colors"`
+	Coaches []*Coach          `xml:"coaches>coach" json:"coaches"`
+	Players []*Player         `xml:"players>player" json:"players"`
+}
+
+// HeadCoach returns the head coach, or nil if the feed lists none.
+func (p *TeamProfile) HeadCoach() *Coach {
+	for _, c := range p.Coaches {
+		if c.Position == "Head Coach" {
+			return c
+		}
+	}
+	return nil
+}
+
+func (p *TeamProfile) setTeamIds() {
+	for _, player := range p.Players {
+		player.TeamId = p.Id
+	}
+}
+
+// TeamColor is a team color, such as the "primary" one, as a hex string like
+// "#0033A0".
+type TeamColor struct {
+	Type     string `xml:"type,attr" json:"type"`
+	HexColor string `xml:"hex_color,attr" json:"hex_color"`
+}
+
+type Coach struct {
+	Id         string `xml:"id,attr" json:"id"`
+	FullName   string `xml:"full_name,attr" json:"full_name"`
+	FirstName  string `xml:"first_name,attr" json:"first_name"`
+	LastName   string `xml:"last_name,attr" json:"last_name"`
+	Position   string `xml:"position,attr" json:"position"`
+	Experience string `xml:"experience,attr" json:"experience"`
+}
+
+type Player struct {
+	Id              string `xml:"id,attr" json:"id"`
+	TeamId          string `xml:"-" json:"-"`
+	Status          string `xml:"status,attr" json:"status"`
+	FullName        string `xml:"full_name,attr" json:"full_name"`
+	FirstName       string `xml:"first_name,attr" json:"first_name"`
+	LastName        string `xml:"last_name,attr" json:"last_name"`
+	JerseyNumber    string `xml:"jersey_number,attr" json:"jersey_number"`
+	Position        string `xml:"position,attr" json:"position"`
+	PrimaryPosition string `xml:"primary_position,attr" json:"primary_position"`
+	// Class is the year of eligibility, such as "FR" or "SR".
+	Class string `xml:"experience,attr" json:"experience"`
+	// Height is in inches and Weight in pounds.
+	Height     int64  `xml:"height,attr" json:"height"`
+	Weight     int64  `xml:"weight,attr" json:"weight"`
+	BirthPlace string `xml:"birth_place,attr" json:"birth_place"`
+	HighSchool string `xml:"high_school,attr" json:"high_school"`
+}
+
+// SeasonalStatistics is the season to date statistics feed of a team.
+type SeasonalStatistics struct {
+	Season       string                  `xml:"-" json:"-"`
+	ScheduleType ScheduleType            `xml:"-" json:"-"`
+	XMLNS        string                  `xml:"xmlns,attr" json:"-"`
+	Id           string                  `xml:"id,attr" json:"id"`
+	Team         *SeasonalTeamStatistics `xml:"team" json:"team"`
+}
+
+type SeasonalTeamStatistics struct {
+	Id       string                      `xml:"id,attr" json:"id"`
+	Name     string                      `xml:"name,attr" json:"name"`
+	Market   string                      `xml:"market,attr" json:"market"`
+	Totals   *SeasonalTotals             `xml:"team_records>overall>total" json:"totals"`
+	Averages *SeasonalAverages           `xml:"team_records>overall>average" json:"averages"`
+	Players  []*SeasonalPlayerStatistics `xml:"player_records>player" json:"players"`
+}
+
+type SeasonalPlayerStatistics struct {
+	Id              string            `xml:"id,attr" json:"id"`
+	FullName        string            `xml:"full_name,attr" json:"full_name"`
+	JerseyNumber    string            `xml:"jersey_number,attr" json:"jersey_number"`
+	Position        string            `xml:"position,attr" json:"position"`
+	PrimaryPosition string            `xml:"primary_position,attr" json:"primary_position"`
+	Totals          *SeasonalTotals   `xml:"overall>total" json:"totals"`
+	Averages        *SeasonalAverages `xml:"overall>average" json:"averages"`
+}
+
+// SeasonalTotals is a season to date stat line with the number of games
+// played and started.
+type SeasonalTotals struct {
+	GamesPlayed  int64
+	GamesStarted int64
+	BoxscoreStatistics
+}
+
+func (s *SeasonalTotals) stats() []sportsdata.Stat {
+	return append([]sportsdata.Stat{
+		sportsdata.IntStat("games_played", &s.GamesPlayed),
+		sportsdata.IntStat("games_started", &s.GamesStarted),
+	}, s.BoxscoreStatistics.stats()...)
+}
+
+func (s *SeasonalTotals) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return sportsdata.UnmarshalStatsXML(d, start, s.stats())
+}
+
+func (s *SeasonalTotals) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXML(e, start, s.stats())
+}
+
+func (s *SeasonalTotals) UnmarshalJSON(data []byte) error {
+	return sportsdata.UnmarshalStatsJSON(data, s.stats())
+}
+
+func (s *SeasonalTotals) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSON(s.stats())
+}
+
+// SeasonalAverages are per game averages. Minutes is the average playing
+// time.
+type SeasonalAverages struct {
+	Minutes              time.Duration
+	Points               float64
+	FieldGoalsMade       float64
+	FieldGoalsAtt        float64
+	ThreePointsMade      float64
+	ThreePointsAttempted float64
+	FreeThrowsMade       float64
+	FreeThrowsAttempted  float64
+	OffensiveRebounds    float64
+	DefensiveRebounds    float64
+	Rebounds             float64
+	Assists              float64
+	Turnovers            float64
+	Steals               float64
+	Blocks               float64
+	PersonalFouls        float64
+}
+
+func (s *SeasonalAverages) stats() []sportsdata.Stat {
+	return []sportsdata.Stat{
+		sportsdata.MinutesStat("minutes", &s.Minutes),
+		sportsdata.FloatStat("points", &s.Points),
+		sportsdata.FloatStat("field_goals_made", &s.FieldGoalsMade),
+		sportsdata.FloatStat("field_goals_att", &s.FieldGoalsAtt),
+		sportsdata.FloatStat("three_points_made", &s.ThreePointsMade),
+		sportsdata.FloatStat("three_points_att", &s.ThreePointsAttempted),
+		sportsdata.FloatStat("free_throws_made", &s.FreeThrowsMade),
+		sportsdata.FloatStat("free_throws_att", &s.FreeThrowsAttempted),
+		sportsdata.FloatStat("offensive_rebounds", &s.OffensiveRebounds),
+		sportsdata.FloatStat("defensive_rebounds", &s.DefensiveRebounds),
+		sportsdata.FloatStat("rebounds", &s.Rebounds),
+		sportsdata.FloatStat("assists", &s.Assists),
+		sportsdata.FloatStat("turnovers", &s.Turnovers),
+		sportsdata.FloatStat("steals", &s.Steals),
+		sportsdata.FloatStat("blocks", &s.Blocks),
+		sportsdata.FloatStat("personal_fouls", &s.PersonalFouls),
+	}
+}
+
+func (s *SeasonalAverages) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return sportsdata.UnmarshalStatsXML(d, start, s.stats())
+}
+
+func (s *SeasonalAverages) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return sportsdata.MarshalStatsXML(e, start, s.stats())
+}
+
+func (s *SeasonalAverages) UnmarshalJSON(data []byte) error {
+	return sportsdata.UnmarshalStatsJSON(data, s.stats())
+}
+
+func (s *SeasonalAverages) MarshalJSON() ([]byte, error) {
+	return sportsdata.MarshalStatsJSON(s.stats())
+}
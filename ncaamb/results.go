@@ -0,0 +1,154 @@
+package ncaamb
+
+import (
+	"context"
+	"log"
+
+	"github.com/tassl-app/sportsdata"
+)
+
+// ScheduleResult pairs a requested season and schedule type with its
+// schedule or the error fetching it.
+type ScheduleResult struct {
+	Season       string
+	ScheduleType ScheduleType
+	Schedule     *Schedule
+	Err          error
+}
+
+type ScheduleResults []*ScheduleResult
+
+// Schedules returns the schedules fetched successfully, in request order.
+func (r ScheduleResults) Schedules() []*Schedule {
+	schedules := make([]*Schedule, 0)
+	for _, result := range r {
+		if result.Err == nil {
+			schedules = append(schedules, result.Schedule)
+		}
+	}
+	return schedules
+}
+
+// Failed returns the results that failed.
+func (r ScheduleResults) Failed() ScheduleResults {
+	failed := make(ScheduleResults, 0)
+	for _, result := range r {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Err returns a *sportsdata.BatchError if any request failed, or nil.
+func (r ScheduleResults) Err() error {
+	errs := make([]error, len(r))
+	for i, result := range r {
+		errs[i] = result.Err
+	}
+	return sportsdata.NewBatchError(errs)
+}
+
+// BoxscoreResult pairs a requested game with its boxscore or the error
+// fetching it.
+type BoxscoreResult struct {
+	GameId   string
+	Boxscore *Boxscore
+	Err      error
+}
+
+type BoxscoreResults []*BoxscoreResult
+
+// Boxscores returns the boxscores fetched successfully, in request order.
+func (r BoxscoreResults) Boxscores() []*Boxscore {
+	boxscores := make([]*Boxscore, 0)
+	for _, result := range r {
+		if result.Err == nil {
+			boxscores = append(boxscores, result.Boxscore)
+		}
+	}
+	return boxscores
+}
+
+// Failed returns the results that failed.
+func (r BoxscoreResults) Failed() BoxscoreResults {
+	failed := make(BoxscoreResults, 0)
+	for _, result := range r {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// FailedIds returns the ids of the games that failed, ready to be passed
+// back to BoxscoresResults.
+func (r BoxscoreResults) FailedIds() []string {
+	ids := make([]string, 0)
+	for _, result := range r.Failed() {
+		ids = append(ids, result.GameId)
+	}
+	return ids
+}
+
+// Err returns a *sportsdata.BatchError if any request failed, or nil.
+func (r BoxscoreResults) Err() error {
+	errs := make([]error, len(r))
+	for i, result := range r {
+		errs[i] = result.Err
+	}
+	return sportsdata.NewBatchError(errs)
+}
+
+// AllSchedulesResults fetches every schedule type of seasons, pairing each with
+// its schedule or error.
+func (a *API) AllSchedulesResults(seasons []string) ScheduleResults {
+	return a.AllSchedulesResultsContext(context.Background(), seasons)
+}
+
+func (a *API) AllSchedulesResultsContext(ctx context.Context, seasons []string) ScheduleResults {
+	results := make(ScheduleResults, 0)
+	for _, season := range seasons {
+		for _, scheduleType := range ScheduleAll {
+			results = append(results, &ScheduleResult{Season: season, ScheduleType: scheduleType})
+		}
+	}
+	err := sportsdata.ForEach(ctx, len(results), a.workers, func(ctx context.Context, i int) error {
+		result := results[i]
+		result.Schedule, result.Err = a.ScheduleContext(ctx, result.Season, result.ScheduleType)
+		return result.Err
+	})
+	if batchErr, ok := err.(*sportsdata.BatchError); ok {
+		for i, err := range batchErr.Errors {
+			results[i].Err = err
+		}
+	}
+	return results
+}
+
+// BoxscoresResults fetches the boxscores of the games with ids, pairing each
+// with its boxscore or error. Results are in ids order.
+func (a *API) BoxscoresResults(ids []string) BoxscoreResults {
+	return a.BoxscoresResultsContext(context.Background(), ids)
+}
+
+func (a *API) BoxscoresResultsContext(ctx context.Context, ids []string) BoxscoreResults {
+	results := make(BoxscoreResults, len(ids))
+	for i, id := range ids {
+		results[i] = &BoxscoreResult{GameId: id}
+	}
+	err := sportsdata.ForEach(ctx, len(results), a.workers, func(ctx context.Context, i int) error {
+		result := results[i]
+		if a.log {
+			log.Printf("Getting boxscore for %s\n", result.GameId)
+		}
+		result.Boxscore, result.Err = a.BoxscoreContext(ctx, result.GameId)
+		return result.Err
+	})
+	if batchErr, ok := err.(*sportsdata.BatchError); ok {
+		for i, err := range batchErr.Errors {
+			results[i].Err = err
+		}
+	}
+	return results
+}
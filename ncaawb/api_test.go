@@ -0,0 +1,248 @@
+package ncaawb
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tassl-app/sportsdata"
+	"github.com/tassl-app/sportsdata/sportsdatatest"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestAPIHTTPClient(t *testing.T) {
+	var requested string
+	client := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			requested = req.URL.Path
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(leagueDivisionData)),
+				Request:    req,
+			}, nil
+		}),
+	}
+	api := NewAPIWithOptions("key", WithHTTPClient(client))
+	league, err := api.League()
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	expectedPath := "/ncaawb-t3/league/hierarchy.xml"
+	if requested != expectedPath {
+		t.Errorf("Expected request path %s, found %s\n", expectedPath, requested)
+		return
+	}
+	expectedLeagueId := "cd4268ee-07aa-4c4d-a435-ec44ad2c76cb"
+	if league.Id != expectedLeagueId {
+		t.Errorf("Expected league id %s, found %s\n", expectedLeagueId, league.Id)
+		return
+	}
+}
+
+func TestAPIContextCanceled(t *testing.T) {
+	client := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			t.Errorf("Expected no request, found %s\n", req.URL.Path)
+			return nil, context.Canceled
+		}),
+	}
+	api := NewAPIWithOptions("key", WithHTTPClient(client))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := api.ScheduleContext(ctx, "2012", ScheduleRegular)
+	if err != context.Canceled {
+		t.Errorf("Expected error %v, found %v\n", context.Canceled, err)
+		return
+	}
+}
+
+func TestAPIBaseURL(t *testing.T) {
+	var requested *url.URL
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL
+		if r.URL.Path != "/proxy/ncaawb-t2/games/2012/reg/schedule.xml" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(leagueScheduleData))
+	}))
+	defer server.Close()
+	api := NewAPIWithOptions("secret", WithBaseURL(server.URL+"/proxy"), WithVersion("2"), WithRateLimiter(nil))
+	schedule, err := api.Schedule("2012", ScheduleRegular)
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	if requested.Query().Get("api_key") != "secret" {
+		t.Errorf("Expected api key %s, found %s\n", "secret", requested.Query().Get("api_key"))
+		return
+	}
+	if len(schedule.Games()) != 2 {
+		t.Errorf("Expected %d games, found %d\n", 2, len(schedule.Games()))
+		return
+	}
+	_, err = api.Schedule("2013", ScheduleRegular)
+	if !errors.Is(err, sportsdata.ErrNotFound) {
+		t.Errorf("Expected error %v, found %v\n", sportsdata.ErrNotFound, err)
+		return
+	}
+	if strings.Contains(err.Error(), "secret") {
+		t.Errorf("Expected api key to be redacted, found %s\n", err.Error())
+		return
+	}
+}
+
+func TestAPIEachScheduleGame(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(leagueScheduleData))
+	}))
+	defer server.Close()
+	api := NewAPIWithOptions("key", WithBaseURL(server.URL), WithRateLimiter(nil))
+	ids := make([]string, 0)
+	err := api.EachScheduleGame("2012", ScheduleRegular, func(game *Game) error {
+		if game.HomeTeam == nil {
+			t.Errorf("Expected home team, found nil\n")
+		}
+		ids = append(ids, game.Id)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	expectedIds := "04d68600-024d-4f46-84aa-257da2f59127,04f5b010-4d33-4374-b270-17cfeae6da64"
+	if strings.Join(ids, ",") != expectedIds {
+		t.Errorf("Expected game ids %s, found %s\n", expectedIds, strings.Join(ids, ","))
+		return
+	}
+}
+
+func TestAPITeamProfile(t *testing.T) {
+	s := sportsdatatest.NewServer()
+	defer s.Close()
+	s.Handle(sportsdatatest.BasketballTeamProfilePath(sportsdatatest.NCAAWB, "fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e"), []byte(`<team id="fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e" name="Wildcats" market="Kentucky" alias="UK" founded="1903">
+  <team_colors><team_color type="primary" hex_color="#0033A0"/></team_colors>
+  <coaches>
+    <coach id="c1" full_name="John Calipari" first_name="John" last_name="Calipari" position="Head Coach"/>
+    <coach id="c2" full_name="Kenny Payne" first_name="Kenny" last_name="Payne" position="Assistant Coach"/>
+  </coaches>
+  <players>
+    <player id="p1" full_name="Karl-Anthony Towns" jersey_number="12" position="F" primary_position="C" experience="FR" height="84" weight="250" birth_place="Piscataway, NJ, USA" high_school="St. Joseph"/>
+  </players>
+</team>`))
+	api := NewAPIWithOptions("key", WithBaseURL(s.URL), WithRateLimiter(nil))
+	profile, err := api.TeamProfile("fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e")
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	if profile.Founded != 1903 || len(profile.Colors) != 1 || profile.Colors[0].HexColor != "#0033A0" {
+		t.Errorf("Expected team founded in %d with %d color, found %+v\n", 1903, 1, profile)
+		return
+	}
+	if coach := profile.HeadCoach(); coach == nil || coach.LastName != "Calipari" {
+		t.Errorf("Expected head coach %s, found %+v\n", "Calipari", coach)
+		return
+	}
+	if len(profile.Players) != 1 {
+		t.Errorf("Expected %d player, found %d\n", 1, len(profile.Players))
+		return
+	}
+	player := profile.Players[0]
+	if player.TeamId != profile.Id || player.Height != 84 || player.Class != "FR" || player.HighSchool != "St. Joseph" {
+		t.Errorf("Expected freshman listed at %d inches, found %+v\n", 84, player)
+		return
+	}
+}
+
+func TestAPISeasonalStatistics(t *testing.T) {
+	s := sportsdatatest.NewServer()
+	defer s.Close()
+	s.Handle(sportsdatatest.BasketballSeasonalStatisticsPath(sportsdatatest.NCAAWB, "2014", string(ScheduleConferenceTournament), "fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e"), []byte(`<season id="s1" year="2014" type="CT">
+  <team id="fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e" name="Wildcats" market="Kentucky">
+    <team_records>
+      <overall>
+        <total games_played="3" minutes="600:00" field_goals_made="78" field_goals_att="160" field_goals_pct="48.8" rebounds="120" points="220"/>
+        <average minutes="200:00" points="73.3" rebounds="40.0"/>
+      </overall>
+    </team_records>
+    <player_records>
+      <player id="p1" full_name="Karl-Anthony Towns" jersey_number="12" position="F" primary_position="C">
+        <overall>
+          <total games_played="3" games_started="3" minutes="75:30" points="42" three_points_pct=""/>
+          <average minutes="25.2" points="14.0"/>
+        </overall>
+      </player>
+    </player_records>
+  </team>
+</season>`))
+	api := NewAPIWithOptions("key", WithBaseURL(s.URL), WithRateLimiter(nil))
+	statistics, err := api.SeasonalStatistics("2014", ScheduleConferenceTournament, "fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e")
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	if statistics.Season != "2014" || statistics.ScheduleType != ScheduleConferenceTournament || statistics.Team == nil {
+		t.Errorf("Expected %s statistics, found %+v\n", ScheduleConferenceTournament, statistics)
+		return
+	}
+	team := statistics.Team
+	if team.Totals.GamesPlayed != 3 || team.Totals.Points != 220 || *team.Totals.FieldGoalsPercent != 48.8 || team.Averages.Points != 73.3 {
+		t.Errorf("Expected %d points in %d games, found %+v %+v\n", 220, 3, team.Totals, team.Averages)
+		return
+	}
+	if len(team.Players) != 1 {
+		t.Errorf("Expected %d player, found %d\n", 1, len(team.Players))
+		return
+	}
+	player := team.Players[0]
+	expectedMinutes := 25*time.Minute + 12*time.Second
+	if player.Totals.GamesStarted != 3 || player.Totals.ThreePointsPercent != nil || player.Averages.Minutes != expectedMinutes {
+		t.Errorf("Expected %d starts averaging %v, found %+v %+v\n", 3, expectedMinutes, player.Totals, player.Averages)
+		return
+	}
+}
+
+func TestAPIDailySchedule(t *testing.T) {
+	date := time.Date(2014, 11, 14, 20, 30, 0, 0, time.UTC)
+	s := sportsdatatest.NewServer()
+	defer s.Close()
+	s.Handle(sportsdatatest.BasketballDailySchedulePath(sportsdatatest.NCAAWB, date), []byte(`<league id="36e93ef4-8270-429c-be2d-bcd108b09507" name="NCAA Division I" alias="NCAA">
+  <daily-schedule date="2014-11-14">
+    <games>
+      <game id="04d68600-024d-4f46-84aa-257da2f59127" status="scheduled" coverage="full" home_team="fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e" away_team="2778e8d4-0b9e-4f55-8f0c-b5b3e0cc5a6f" scheduled="2014-11-14T23:00:00+00:00">
+        <home name="Kentucky Wildcats" alias="UK" id="fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e"/>
+        <away name="Boston College Eagles" alias="BC" id="2778e8d4-0b9e-4f55-8f0c-b5b3e0cc5a6f"/>
+      </game>
+    </games>
+  </daily-schedule>
+</league>`))
+	api := NewAPIWithOptions("key", WithBaseURL(s.URL), WithRateLimiter(nil))
+	schedule, err := api.DailySchedule(date)
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	expectedDate := time.Date(2014, 11, 14, 0, 0, 0, 0, time.UTC)
+	if !schedule.Date.Equal(expectedDate) {
+		t.Errorf("Expected date %v, found %v\n", expectedDate, schedule.Date)
+		return
+	}
+	games := schedule.Games()
+	if len(games) != 1 || games[0].HomeTeam == nil {
+		t.Errorf("Expected %d game with home team, found %+v\n", 1, games)
+		return
+	}
+}
@@ -1,6 +1,7 @@
 package ncaawb
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"testing"
 	"time"
@@ -43,6 +44,171 @@ const leagueScheduleData = `
 </league>
 `
 
+const boxscoreData = `
+<game xmlns="http://feed.elasticstats.com/schema/basketball/game-v2.0.xsd" id="04d68600-024d-4f46-84aa-257da2f59127" status="closed" coverage="full" home_team="fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e" away_team="2778e8d4-0b9e-4f55-8f0c-b5b3e0cc5a6f" scheduled="2014-11-14T23:00:00+00:00" attendance="14781" lead_changes="4" times_tied="3">
+  <team name="Wildcats" market="Kentucky" id="fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e" points="71" rank="1">
+    <scoring>
+      <half number="1" sequence="1" points="36"/>
+      <half number="2" sequence="2" points="35"/>
+    </scoring>
+    <leaders>
+      <points>
+        <player full_name="Aaron Harrison" jersey_number="2" id="3f5c7f1c-0f86-4f3b-8a5b-4f6d8a1e2a10" position="G">
+          <statistics minutes="31:24" field_goals_made="6" field_goals_att="13" field_goals_pct="46.2" three_points_made="2" three_points_att="6" three_points_pct="33.3" two_points_made="4" two_points_att="7" two_points_pct="57.1" free_throws_made="4" free_throws_att="4" free_throws_pct="100.0" offensive_rebounds="1" defensive_rebounds="3" rebounds="4" assists="2" turnovers="1" steals="1" blocks="0" assists_turnover_ratio="2.0" personal_fouls="2" tech_fouls="" points="18"/>
+        </player>
+      </points>
+      <rebounds>
+        <player full_name="Willie Cauley-Stein" jersey_number="15" id="0b2e6c5d-7d6a-4a8f-9e4b-2a6f3c1d8e91" position="F">
+          <statistics minutes="24:10" offensive_rebounds="3" defensive_rebounds="5" rebounds="8" points="9"/>
+        </player>
+        <player full_name="Karl-Anthony Towns" jersey_number="12" id="5c1e2f4a-3b6d-4e8f-a1c2-9d7b6e5f4a32" position="F">
+          <statistics minutes="21:45" offensive_rebounds="2" defensive_rebounds="6" rebounds="8" points="11"/>
+        </player>
+      </rebounds>
+      <assists>
+        <player full_name="Tyler Ulis" jersey_number="3" id="9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b" position="G">
+          <statistics minutes="19:02" assists="5" turnovers="1" points="4"/>
+        </player>
+      </assists>
+    </leaders>
+  </team>
+  <team name="Eagles" market="Boston College" id="2778e8d4-0b9e-4f55-8f0c-b5b3e0cc5a6f" points="62">
+    <scoring>
+      <half number="1" sequence="1" points="30"/>
+      <half number="2" sequence="2" points="32"/>
+    </scoring>
+    <leaders>
+      <points>
+        <player full_name="Olivier Hanlan" jersey_number="21" id="7ad05b2c-0c9b-4b8e-b9ef-3d8a8e6c0c52" position="G">
+          <statistics minutes="38:00" field_goals_made="7" field_goals_att="15" field_goals_pct="46.7" three_points_made="0" three_points_att="0" three_points_pct="" free_throws_made="6" free_throws_att="8" free_throws_pct="75.0" rebounds="5" assists="3" points="20"/>
+        </player>
+      </points>
+    </leaders>
+  </team>
+</game>
+`
+
+const playByPlayData = `
+<game xmlns="http://feed.elasticstats.com/schema/basketball/pbp-v2.0.xsd" id="04d68600-024d-4f46-84aa-257da2f59127" status="closed" coverage="full" home_team="fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e" away_team="2778e8d4-0b9e-4f55-8f0c-b5b3e0cc5a6f" scheduled="2014-11-14T23:00:00+00:00">
+  <team name="Wildcats" market="Kentucky" id="fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e"/>
+  <team name="Eagles" market="Boston College" id="2778e8d4-0b9e-4f55-8f0c-b5b3e0cc5a6f"/>
+  <time_zones venue="US/Eastern"/>
+  <broadcast network="ESPN"/>
+  <half number="1" sequence="1">
+    <events>
+      <event id="a1b2c3d4-0001" clock="19:42" event_type="threepointmade" home_points="3" away_points="0">
+        <attribution name="Wildcats" market="Kentucky" id="fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e"/>
+        <location coord_x="312" coord_y="420"/>
+        <description>Aaron Harrison makes three point jump shot. Tyler Ulis assists.</description>
+        <statistics>
+          <fieldgoal made="true" shot_type="jump shot" points="3" three_point_shot="true">
+            <team name="Wildcats" market="Kentucky" id="fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e"/>
+            <player full_name="Aaron Harrison" jersey_number="2" id="3f5c7f1c-0f86-4f3b-8a5b-4f6d8a1e2a10"/>
+          </fieldgoal>
+          <assist>
+            <team name="Wildcats" market="Kentucky" id="fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e"/>
+            <player full_name="Tyler Ulis" jersey_number="3" id="9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b"/>
+          </assist>
+        </statistics>
+      </event>
+      <event id="a1b2c3d4-0002" clock="19:20" event_type="twopointmiss" home_points="3" away_points="0">
+        <attribution name="Eagles" market="Boston College" id="2778e8d4-0b9e-4f55-8f0c-b5b3e0cc5a6f"/>
+        <location coord_x="80" coord_y="250"/>
+        <description>Olivier Hanlan misses layup.</description>
+        <statistics>
+          <fieldgoal made="false" shot_type="layup" points="0" three_point_shot="false">
+            <team name="Eagles" market="Boston College" id="2778e8d4-0b9e-4f55-8f0c-b5b3e0cc5a6f"/>
+            <player full_name="Olivier Hanlan" jersey_number="21" id="7ad05b2c-0c9b-4b8e-b9ef-3d8a8e6c0c52"/>
+          </fieldgoal>
+          <rebound type="defensive">
+            <team name="Wildcats" market="Kentucky" id="fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e"/>
+            <player full_name="Karl-Anthony Towns" jersey_number="12" id="5c1e2f4a-3b6d-4e8f-a1c2-9d7b6e5f4a32"/>
+          </rebound>
+        </statistics>
+      </event>
+    </events>
+  </half>
+  <half number="2" sequence="2">
+    <events>
+      <event id="a1b2c3d4-0003" clock="0:00" event_type="endperiod" home_points="71" away_points="62">
+        <description>End of 2nd Half.</description>
+      </event>
+    </events>
+  </half>
+  <overtime number="1" sequence="3">
+    <events/>
+  </overtime>
+</game>
+`
+
+const gameSummaryData = `
+<game xmlns="http://feed.elasticstats.com/schema/basketball/game-v2.0.xsd" id="04d68600-024d-4f46-84aa-257da2f59127" status="closed" coverage="full" home_team="fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e" away_team="2778e8d4-0b9e-4f55-8f0c-b5b3e0cc5a6f" scheduled="2014-11-14T23:00:00+00:00" attendance="14781" lead_changes="4" times_tied="3" clock="00:00" half="2">
+  <team name="Wildcats" market="Kentucky" id="fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e" points="71" rank="1">
+    <scoring>
+      <half number="1" sequence="1" points="36"/>
+      <half number="2" sequence="2" points="35"/>
+    </scoring>
+    <statistics minutes="200:00" field_goals_made="25" field_goals_att="58" field_goals_pct="43.1" three_points_made="7" three_points_att="19" three_points_pct="36.8" free_throws_made="14" free_throws_att="18" free_throws_pct="77.8" offensive_rebounds="12" defensive_rebounds="27" rebounds="41" assists="13" turnovers="9" steals="6" blocks="8" personal_fouls="15" points="71" team_rebounds="3" team_turnovers="1"/>
+    <players>
+      <player full_name="Aaron Harrison" jersey_number="2" id="3f5c7f1c-0f86-4f3b-8a5b-4f6d8a1e2a10" position="G" primary_position="SG" played="true" active="true" starter="true">
+        <statistics minutes="31:24" field_goals_made="6" field_goals_att="13" field_goals_pct="46.2" three_points_made="2" three_points_att="6" three_points_pct="33.3" free_throws_made="4" free_throws_att="4" free_throws_pct="100.0" offensive_rebounds="1" defensive_rebounds="3" rebounds="4" assists="2" turnovers="1" steals="1" blocks="0" personal_fouls="2" points="18" plus_minus="11"/>
+      </player>
+      <player full_name="Tyler Ulis" jersey_number="3" id="9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b" position="G" primary_position="PG" played="true" active="true" starter="false">
+        <statistics minutes="19:02" field_goals_made="2" field_goals_att="4" field_goals_pct="50.0" three_points_made="0" three_points_att="0" three_points_pct="" assists="5" turnovers="1" points="4" plus_minus="-3"/>
+      </player>
+      <player full_name="Alex Poythress" jersey_number="22" id="1d2c3b4a-5f6e-4d8c-9b0a-1f2e3d4c5b6a" position="F" primary_position="PF" played="false" active="false" starter="false" not_playing_reason="Injury" not_playing_description="Knee"/>
+    </players>
+  </team>
+  <team name="Eagles" market="Boston College" id="2778e8d4-0b9e-4f55-8f0c-b5b3e0cc5a6f" points="62">
+    <statistics minutes="200:00" points="62"/>
+    <players/>
+  </team>
+</game>
+`
+
+const leagueScheduleJSONData = `
+{
+	"league": {"id": "36e93ef4-8270-429c-be2d-bcd108b09507", "name": "NCAA MEN", "alias": "NCAAM"},
+	"season": {"id": "562c84a7-b3eb-4b95-8435-6e3e1624e007", "year": 2012, "type": "REG"},
+	"games": [
+		{
+			"id": "04d68600-024d-4f46-84aa-257da2f59127",
+			"status": "scheduled",
+			"coverage": "full",
+			"scheduled": "2012-11-09T14:22:00+00:00",
+			"venue": {"id": "c06cdbce-91ba-4306-b31c-97df5cbf2515", "name": "Devlin Fieldhouse", "capacity": 3600, "city": "New Orleans", "state": "LA", "country": "USA"},
+			"home": {"name": "Green Wave", "alias": "TULN", "id": "f861db3e-c1fc-4e90-9f17-db0d5f0f3e8b"},
+			"away": {"name": "Yellow Jackets", "alias": "GT", "id": "35422c09-b48a-4a85-b99e-a2b06badd15e"}
+		},
+		{
+			"id": "04f5b010-4d33-4374-b270-17cfeae6da64",
+			"status": "scheduled",
+			"coverage": "full",
+			"scheduled": "2012-11-09T14:22:00+00:00",
+			"home": {"name": "Zips", "alias": "AKR", "id": "98076615-ab08-4e9f-88ef-bab6702fd66b"},
+			"away": {"name": "Chanticleers", "alias": "CCAR", "id": "ed08d6a7-580a-4d94-b4cc-4718be73cd10"}
+		}
+	]
+}
+`
+
+const leagueDailyScheduleJSONData = `
+{
+	"date": "2014-11-14",
+	"league": {"id": "36e93ef4-8270-429c-be2d-bcd108b09507", "name": "NCAA MEN", "alias": "NCAAM"},
+	"games": [
+		{
+			"id": "04d68600-024d-4f46-84aa-257da2f59127",
+			"status": "closed",
+			"coverage": "full",
+			"scheduled": "2014-11-14T23:00:00+00:00",
+			"home": {"name": "Wildcats", "alias": "UK", "id": "fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e"},
+			"away": {"name": "Grizzlies", "alias": "GRAM", "id": "2778e8d4-0b9e-4f55-8f0c-b5b3e0cc5a6f"}
+		}
+	]
+}
+`
+
 func TestLeagueDivision(t *testing.T) {
 	v := new(League)
 	err := xml.Unmarshal([]byte(leagueDivisionData), v)
@@ -157,3 +323,272 @@ func TestLeagueSchedule(t *testing.T) {
 		return
 	}
 }
+
+func TestBoxscoreLeaders(t *testing.T) {
+	v := new(Boxscore)
+	err := xml.Unmarshal([]byte(boxscoreData), v)
+	if err != nil {
+		t.Errorf("Could not unmarshal xml. Error: %s\n", err.Error())
+		return
+	}
+	player := v.HomeTeam().Leaders.Points.Player
+	expectedName := "Aaron Harrison"
+	if player.FullName != expectedName {
+		t.Errorf("Expected points leader %s, found %s\n", expectedName, player.FullName)
+		return
+	}
+	stats := player.Statistics
+	expectedMinutes := 31*time.Minute + 24*time.Second
+	if stats.Minutes != expectedMinutes {
+		t.Errorf("Expected minutes %v, found %v\n", expectedMinutes, stats.Minutes)
+		return
+	}
+	if stats.Points != 18 || stats.FieldGoalsAtt != 13 || stats.TwoPointsAttempted != 7 || stats.AssistsTurnoverRatio != 2 || stats.TechFouls != 0 {
+		t.Errorf("Expected %d points on %d shots, found %+v\n", 18, 13, stats)
+		return
+	}
+	if stats.FreeThrowsPercent == nil || *stats.FreeThrowsPercent != 100 {
+		t.Errorf("Expected free throw percentage %v, found %v\n", 100.0, stats.FreeThrowsPercent)
+		return
+	}
+	rebounders := v.HomeTeam().Leaders.Rebounds.Players
+	if len(rebounders) != 2 || rebounders[1].Statistics.Rebounds != 8 {
+		t.Errorf("Expected %d players tied with %d rebounds, found %+v\n", 2, 8, rebounders)
+		return
+	}
+	assisters := v.HomeTeam().Leaders.Assists.Players
+	if len(assisters) != 1 || assisters[0].Statistics.Assists != 5 {
+		t.Errorf("Expected %d player with %d assists, found %+v\n", 1, 5, assisters)
+		return
+	}
+	if v.HomeTeam().Leaders.Rebounds.Player != rebounders[0] {
+		t.Errorf("Expected rebound leader %+v, found %+v\n", rebounders[0], v.HomeTeam().Leaders.Rebounds.Player)
+		return
+	}
+	if v.HomeTeam().Leaders.Assists.Player != assisters[0] {
+		t.Errorf("Expected assist leader %+v, found %+v\n", assisters[0], v.HomeTeam().Leaders.Assists.Player)
+		return
+	}
+	if v.AwayTeam().Leaders.Rebounds != nil {
+		t.Errorf("Expected no rebound leaders, found %+v\n", v.AwayTeam().Leaders.Rebounds)
+		return
+	}
+	if v.LeadChanges != 4 {
+		t.Errorf("Expected %d lead changes, found %d\n", 4, v.LeadChanges)
+		return
+	}
+	stats = v.AwayTeam().Leaders.Points.Player.Statistics
+	if stats.ThreePointsPercent != nil {
+		t.Errorf("Expected no three point percentage, found %v\n", *stats.ThreePointsPercent)
+		return
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	decoded := new(Boxscore)
+	err = json.Unmarshal(data, decoded)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if len(decoded.HomeTeam().Leaders.Rebounds.Players) != 2 {
+		t.Errorf("Expected %d rebound leaders, found %+v\n", 2, decoded.HomeTeam().Leaders.Rebounds)
+		return
+	}
+	if decoded.HomeTeam().Leaders.Rebounds.Player == nil || decoded.HomeTeam().Leaders.Rebounds.Player.Statistics.Rebounds != 8 {
+		t.Errorf("Expected rebound leader with %d rebounds, found %+v\n", 8, decoded.HomeTeam().Leaders.Rebounds.Player)
+		return
+	}
+	decodedStats := decoded.HomeTeam().Leaders.Points.Player.Statistics
+	if decodedStats.Minutes != expectedMinutes || decodedStats.FieldGoalsPercent == nil || *decodedStats.FieldGoalsPercent != 46.2 {
+		t.Errorf("Expected statistics %+v, found %+v\n", player.Statistics, decodedStats)
+		return
+	}
+}
+
+func TestPlayByPlay(t *testing.T) {
+	v := new(PlayByPlay)
+	err := xml.Unmarshal([]byte(playByPlayData), v)
+	if err != nil {
+		t.Errorf("Could not unmarshal xml. Error: %s\n", err.Error())
+		return
+	}
+	if !v.Closed() || len(v.Teams) != 2 {
+		t.Errorf("Expected closed game with %d teams, found %+v\n", 2, v)
+		return
+	}
+	if len(v.Periods) != 3 || v.Periods[1].Number != 2 || v.Periods[1].Overtime() || !v.Periods[2].Overtime() {
+		t.Errorf("Expected %d halves and an overtime, found %+v\n", 2, v.Periods)
+		return
+	}
+	events := v.Events()
+	if len(events) != 3 {
+		t.Errorf("Expected %d events, found %d\n", 3, len(events))
+		return
+	}
+	event := events[0]
+	shot := event.Shot()
+	if shot == nil || !shot.Made || !shot.ThreePointShot || shot.Points != 3 || event.HomePoints != 3 {
+		t.Errorf("Expected made three, found %+v\n", shot)
+		return
+	}
+	if event.Location == nil || event.Location.X != 312 || event.Location.Y != 420 {
+		t.Errorf("Expected location %d,%d, found %+v\n", 312, 420, event.Location)
+		return
+	}
+	players := event.Players()
+	if len(players) != 2 || players[1].FullName != "Tyler Ulis" {
+		t.Errorf("Expected shooter and assist, found %+v\n", players)
+		return
+	}
+	miss := events[1]
+	if miss.Shot().Made || miss.Statistics.Rebounds[0].Type != "defensive" || miss.Team.Market != "Boston College" {
+		t.Errorf("Expected missed layup and defensive rebound, found %+v\n", miss.Statistics)
+		return
+	}
+	if events[2].Shot() != nil || len(events[2].Players()) != 0 || events[2].AwayPoints != 62 {
+		t.Errorf("Expected end of period, found %+v\n", events[2])
+		return
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	decoded := new(PlayByPlay)
+	err = json.Unmarshal(data, decoded)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if len(decoded.Periods) != 3 || !decoded.Periods[2].Overtime() {
+		t.Errorf("Expected overtime in JSON, found %+v\n", decoded.Periods)
+		return
+	}
+	data, err = xml.Marshal(v)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	decoded = new(PlayByPlay)
+	err = xml.Unmarshal(data, decoded)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if len(decoded.Periods) != 3 || !decoded.Periods[2].Overtime() || len(decoded.Events()) != 3 {
+		t.Errorf("Expected overtime in XML, found %s\n", data)
+		return
+	}
+}
+
+func TestGameSummary(t *testing.T) {
+	v := new(GameSummary)
+	err := xml.Unmarshal([]byte(gameSummaryData), v)
+	if err != nil {
+		t.Errorf("Could not unmarshal xml. Error: %s\n", err.Error())
+		return
+	}
+	home := v.HomeTeam()
+	if home == nil || home.Points != 71 || v.AwayTeam().Points != 62 || !v.Closed() {
+		t.Errorf("Expected final score %d-%d, found %+v\n", 71, 62, v.Teams)
+		return
+	}
+	totals := home.Statistics
+	if totals.Minutes != 200*time.Minute || totals.Rebounds != 41 || totals.OffensiveRebounds != 12 || totals.TeamRebounds != 3 {
+		t.Errorf("Expected team totals of %d rebounds, found %+v\n", 41, totals)
+		return
+	}
+	if len(home.Players) != 3 {
+		t.Errorf("Expected %d players, found %d\n", 3, len(home.Players))
+		return
+	}
+	starters := home.Starters()
+	if len(starters) != 1 || starters[0].Statistics.PlusMinus != 11 || starters[0].Statistics.FreeThrowsAttempted != 4 {
+		t.Errorf("Expected %d starter with plus minus %d, found %+v\n", 1, 11, starters)
+		return
+	}
+	bench := home.Players[1].Statistics
+	if bench.PlusMinus != -3 || bench.ThreePointsPercent != nil {
+		t.Errorf("Expected plus minus %d and no three point percentage, found %+v\n", -3, bench)
+		return
+	}
+	injured := home.Players[2]
+	if injured.Played || injured.NotPlayingReason != "Injury" || injured.Statistics != nil {
+		t.Errorf("Expected player out with injury, found %+v\n", injured)
+		return
+	}
+}
+
+func TestLeagueScheduleJSON(t *testing.T) {
+	v := new(League)
+	err := json.Unmarshal([]byte(leagueScheduleJSONData), v)
+	if err != nil {
+		t.Errorf("Could not unmarshal json. Error: %s\n", err.Error())
+		return
+	}
+	expectedLeagueAlias := "NCAAM"
+	if v.Alias != expectedLeagueAlias {
+		t.Errorf("Expected league alias %s, found %s\n", expectedLeagueAlias, v.Alias)
+		return
+	}
+	if v.SeasonSchedule == nil || v.SeasonSchedule.Year != "2012" || v.SeasonSchedule.SeasonType != "REG" {
+		t.Errorf("Expected season %s %s, found %+v\n", "2012", "REG", v.SeasonSchedule)
+		return
+	}
+	games := v.SeasonSchedule.Games.Games
+	if len(games) != 2 {
+		t.Errorf("Expected %d games, found %d\n", 2, len(games))
+		return
+	}
+	expectedHomeTeamId := "f861db3e-c1fc-4e90-9f17-db0d5f0f3e8b"
+	if games[0].HomeTeamId != expectedHomeTeamId {
+		t.Errorf("Expected home team id %s, found %s\n", expectedHomeTeamId, games[0].HomeTeamId)
+		return
+	}
+	expectedAwayTeamId := "ed08d6a7-580a-4d94-b4cc-4718be73cd10"
+	if games[1].AwayTeamId != expectedAwayTeamId {
+		t.Errorf("Expected away team id %s, found %s\n", expectedAwayTeamId, games[1].AwayTeamId)
+		return
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	decoded := new(League)
+	err = json.Unmarshal(data, decoded)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if decoded.Id != v.Id || decoded.SeasonSchedule == nil || len(decoded.SeasonSchedule.Games.Games) != 2 {
+		t.Errorf("Expected schedule %+v, found %+v\n", v, decoded)
+		return
+	}
+}
+
+func TestLeagueDailyScheduleJSON(t *testing.T) {
+	v := new(League)
+	err := json.Unmarshal([]byte(leagueDailyScheduleJSONData), v)
+	if err != nil {
+		t.Errorf("Could not unmarshal json. Error: %s\n", err.Error())
+		return
+	}
+	if v.SeasonSchedule != nil {
+		t.Errorf("Expected no season schedule, found %+v\n", v.SeasonSchedule)
+		return
+	}
+	expectedDate := "2014-11-14"
+	if v.DailySchedule == nil || v.DailySchedule.Date != expectedDate {
+		t.Errorf("Expected daily schedule for %s, found %+v\n", expectedDate, v.DailySchedule)
+		return
+	}
+	games := v.DailySchedule.Games.Games
+	if len(games) != 1 || games[0].HomeTeamId != "fc6fa3a3-2a2b-4c2e-9a84-9d3f5a5b1b7e" {
+		t.Errorf("Expected %d game, found %+v\n", 1, games)
+		return
+	}
+}
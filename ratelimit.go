@@ -0,0 +1,99 @@
+package sportsdata
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Request rates allowed by each Sports Data access level.
+const (
+	TrialRequestsPerSecond      = 1
+	ProductionRequestsPerSecond = 5
+)
+
+// RateLimiter is a token bucket that is safe for concurrent use. A single
+// limiter may be shared by the ncaafb, ncaamb and ncaawb clients so that they
+// draw on the same account quota. A nil *RateLimiter never blocks.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a limiter that allows requestsPerSecond on average
+// and up to burst requests at once. The bucket starts full. A rate of zero
+// or less does not limit requests.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// NewAccessLevelRateLimiter returns a limiter for the trial or production
+// access level.
+func NewAccessLevelRateLimiter(production bool) *RateLimiter {
+	if production {
+		return NewRateLimiter(ProductionRequestsPerSecond, ProductionRequestsPerSecond)
+	}
+	return NewRateLimiter(TrialRequestsPerSecond, TrialRequestsPerSecond)
+}
+
+// Wait blocks until a request may be made or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if l == nil {
+		return nil
+	}
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve takes a token, possibly going into debt, and returns how long the
+// caller has to wait before the token is valid.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 || l.rate <= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a token taken by an abandoned reservation.
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens++
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
@@ -0,0 +1,46 @@
+package sportsdata
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterBurst(t *testing.T) {
+	l := NewRateLimiter(20, 2)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Errorf("Error: %s\n", err.Error())
+			return
+		}
+	}
+	elapsed := time.Since(start)
+	if elapsed < 40*time.Millisecond {
+		t.Errorf("Expected third request to wait, waited %v\n", elapsed)
+		return
+	}
+}
+
+func TestRateLimiterCanceled(t *testing.T) {
+	l := NewRateLimiter(0.1, 1)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := l.Wait(ctx)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected error %v, found %v\n", context.DeadlineExceeded, err)
+		return
+	}
+}
+
+func TestRateLimiterNil(t *testing.T) {
+	var l *RateLimiter
+	if err := l.Wait(context.Background()); err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+}
@@ -0,0 +1,129 @@
+package sportsdata
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy retries requests that fail with a network error, 429 Too Many
+// Requests or a 5xx status. Other 4xx responses are never retried. A nil
+// *RetryPolicy makes a single attempt.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. It doubles with every
+	// attempt, with jitter, up to MaxDelay.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// OnRetry, if set, is called before every retry.
+	OnRetry func(RetryAttempt)
+}
+
+// RetryAttempt describes a failed attempt that is about to be retried.
+type RetryAttempt struct {
+	// Attempt is the number of the failed attempt, starting at 1.
+	Attempt int
+	// StatusCode is the response status, or 0 if the request failed.
+	StatusCode int
+	// Err is the request error, or nil if a response was received.
+	Err error
+	// Delay is how long the policy waits before the next attempt.
+	Delay time.Duration
+}
+
+// DefaultRetryPolicy returns a policy making up to 4 attempts with delays
+// starting at one second.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   1 * time.Second,
+		MaxDelay:    30 * time.Second,
+	}
+}
+
+// Retryable reports whether a response with statusCode should be retried.
+func Retryable(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// Backoff returns the jittered delay after the given failed attempt.
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay <= 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	half := int64(delay / 2)
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// RetryAfter returns the delay requested by the Retry-After header of resp,
+// given either in seconds or as an HTTP date.
+func RetryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			seconds = 0
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		delay := t.Sub(time.Now())
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// Do calls attempt until it returns a response that should not be retried,
+// the policy runs out of attempts or ctx is done. The last response or error
+// is returned; its status code is not checked.
+func (p *RetryPolicy) Do(ctx context.Context, attempt func() (*http.Response, error)) (*http.Response, error) {
+	for n := 1; ; n++ {
+		resp, err := attempt()
+		if p == nil || n >= p.MaxAttempts || ctx.Err() != nil {
+			return resp, err
+		}
+		retry := RetryAttempt{Attempt: n, Err: err}
+		if err == nil {
+			if !Retryable(resp.StatusCode) {
+				return resp, nil
+			}
+			retry.StatusCode = resp.StatusCode
+			if delay, ok := RetryAfter(resp); ok && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+				retry.Delay = delay
+			} else {
+				retry.Delay = p.Backoff(n)
+			}
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		} else {
+			retry.Delay = p.Backoff(n)
+		}
+		if p.OnRetry != nil {
+			p.OnRetry(retry)
+		}
+		timer := time.NewTimer(retry.Delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package sportsdata
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func retryServer(statuses ...int) (*httptest.Server, *int) {
+	requests := new(int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := statuses[len(statuses)-1]
+		if *requests < len(statuses) {
+			status = statuses[*requests]
+		}
+		*requests++
+		if status == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "0")
+		}
+		w.WriteHeader(status)
+	}))
+	return server, requests
+}
+
+func TestRetryPolicyRetries(t *testing.T) {
+	server, requests := retryServer(http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusOK)
+	defer server.Close()
+	attempts := make([]RetryAttempt, 0)
+	p := &RetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   time.Millisecond,
+		OnRetry: func(a RetryAttempt) {
+			attempts = append(attempts, a)
+		},
+	}
+	resp, err := p.Do(context.Background(), func() (*http.Response, error) {
+		return http.Get(server.URL)
+	})
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status %d, found %d\n", http.StatusOK, resp.StatusCode)
+		return
+	}
+	if *requests != 3 {
+		t.Errorf("Expected %d requests, found %d\n", 3, *requests)
+		return
+	}
+	if len(attempts) != 2 {
+		t.Errorf("Expected %d retries, found %d\n", 2, len(attempts))
+		return
+	}
+	if attempts[0].StatusCode != http.StatusTooManyRequests || attempts[0].Delay != 0 {
+		t.Errorf("Expected Retry-After to be honored, found %+v\n", attempts[0])
+		return
+	}
+}
+
+func TestRetryPolicyClientError(t *testing.T) {
+	server, requests := retryServer(http.StatusNotFound)
+	defer server.Close()
+	p := &RetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond}
+	resp, err := p.Do(context.Background(), func() (*http.Response, error) {
+		return http.Get(server.URL)
+	})
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	resp.Body.Close()
+	if *requests != 1 {
+		t.Errorf("Expected %d requests, found %d\n", 1, *requests)
+		return
+	}
+}
+
+func TestRetryPolicyMaxAttempts(t *testing.T) {
+	server, requests := retryServer(http.StatusBadGateway)
+	defer server.Close()
+	p := &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	resp, err := p.Do(context.Background(), func() (*http.Response, error) {
+		return http.Get(server.URL)
+	})
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadGateway {
+		t.Errorf("Expected status %d, found %d\n", http.StatusBadGateway, resp.StatusCode)
+		return
+	}
+	if *requests != 3 {
+		t.Errorf("Expected %d requests, found %d\n", 3, *requests)
+		return
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+	for attempt, max := range []time.Duration{100, 200, 300, 300} {
+		max *= time.Millisecond
+		delay := p.Backoff(attempt + 1)
+		if delay < max/2 || delay > max {
+			t.Errorf("Expected attempt %d delay between %v and %v, found %v\n", attempt+1, max/2, max, delay)
+			return
+		}
+	}
+}
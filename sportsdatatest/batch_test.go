@@ -0,0 +1,188 @@
+package sportsdatatest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tassl-app/sportsdata"
+	"github.com/tassl-app/sportsdata/ncaafb"
+	"github.com/tassl-app/sportsdata/ncaamb"
+	"github.com/tassl-app/sportsdata/sportsdatatest"
+)
+
+// countingTransport records the largest number of requests in flight at once.
+type countingTransport struct {
+	mu         sync.Mutex
+	running    int
+	maxRunning int
+}
+
+func (c *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	c.running++
+	if c.running > c.maxRunning {
+		c.maxRunning = c.running
+	}
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.running--
+		c.mu.Unlock()
+	}()
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestBoxscoresConcurrent(t *testing.T) {
+	s := sportsdatatest.NewServer()
+	defer s.Close()
+	ids := make([]string, 0)
+	for i := 0; i < 12; i++ {
+		id := fmt.Sprintf("game-%d", i)
+		ids = append(ids, id)
+		if i != 5 {
+			s.HandleValue(sportsdatatest.BasketballBoxscorePath(sportsdatatest.NCAAMB, id), &ncaamb.Boxscore{Id: id})
+		}
+	}
+	s.Inject("", sportsdatatest.Latency(20*time.Millisecond))
+	transport := new(countingTransport)
+	api := ncaamb.NewAPIWithOptions("key",
+		ncaamb.WithBaseURL(s.URL),
+		ncaamb.WithHTTPClient(&http.Client{Transport: transport}),
+		ncaamb.WithRateLimiter(sportsdata.NewRateLimiter(1000, 12)),
+		ncaamb.WithConcurrency(4),
+	)
+	boxscores, err := api.Boxscores(ids)
+	if transport.maxRunning < 2 || transport.maxRunning > 4 {
+		t.Errorf("Expected between %d and %d concurrent requests, found %d\n", 2, 4, transport.maxRunning)
+	}
+	var batchErr *sportsdata.BatchError
+	if !errors.As(err, &batchErr) || batchErr.Failed() != 1 || !errors.Is(batchErr.Errors[5], sportsdata.ErrNotFound) {
+		t.Errorf("Expected game %d to fail with %v, found %v\n", 5, sportsdata.ErrNotFound, err)
+		return
+	}
+	if len(boxscores) != len(ids) {
+		t.Errorf("Expected %d boxscores, found %d\n", len(ids), len(boxscores))
+		return
+	}
+	for i, boxscore := range boxscores {
+		if i == 5 {
+			if boxscore != nil {
+				t.Errorf("Expected nil boxscore for failed game, found %+v\n", boxscore)
+			}
+			continue
+		}
+		if boxscore == nil || boxscore.Id != ids[i] {
+			t.Errorf("Expected boxscore %s at %d, found %+v\n", ids[i], i, boxscore)
+			return
+		}
+	}
+}
+
+func TestAllSchedulesResults(t *testing.T) {
+	s := sportsdatatest.NewServer()
+	defer s.Close()
+	for _, year := range []string{"2013", "2014"} {
+		s.HandleValue(sportsdatatest.FootballSchedulePath(year, "reg"), &ncaafb.Season{Season: year})
+		s.HandleValue(sportsdatatest.FootballSchedulePath(year, "pst"), &ncaafb.Season{Season: year})
+	}
+	s.Inject(sportsdatatest.FootballSchedulePath("2014", "pst"), sportsdatatest.ServerError(0))
+	api := ncaafb.NewAPIWithOptions("key", ncaafb.WithBaseURL(s.URL), ncaafb.WithRateLimiter(nil), ncaafb.WithConcurrency(2))
+	results := api.AllSchedulesResults([]string{"2013", "2014"})
+	if len(results) != 4 {
+		t.Errorf("Expected %d results, found %d\n", 4, len(results))
+		return
+	}
+	if len(results.Schedules()) != 3 {
+		t.Errorf("Expected %d schedules, found %d\n", 3, len(results.Schedules()))
+		return
+	}
+	failed := results.Failed()
+	if len(failed) != 1 || failed[0].Year != "2014" || failed[0].ScheduleType != ncaafb.SchedulePostSeason {
+		t.Errorf("Expected 2014 post season to fail, found %+v\n", failed)
+		return
+	}
+	if !errors.Is(failed[0].Err, sportsdata.ErrServer) || !errors.Is(results.Err(), sportsdata.ErrServer) {
+		t.Errorf("Expected error %v, found %v\n", sportsdata.ErrServer, failed[0].Err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results = api.AllSchedulesResultsContext(ctx, []string{"2013"})
+	for _, result := range results {
+		if result.Err != context.Canceled {
+			t.Errorf("Expected error %v, found %v\n", context.Canceled, result.Err)
+			return
+		}
+	}
+}
+
+func TestScheduleBoxscoresResults(t *testing.T) {
+	s := sportsdatatest.NewServer()
+	defer s.Close()
+	schedule := &ncaafb.Schedule{
+		Year:         "2014",
+		ScheduleType: ncaafb.ScheduleRegular,
+		Season: &ncaafb.Season{Weeks: []*ncaafb.Week{
+			{Week: "1", Games: []*ncaafb.Game{{Id: "a", AwayTeamId: "AUB", HomeTeamId: "KST"}}},
+			{Week: "2", Games: []*ncaafb.Game{{Id: "b", AwayTeamId: "SHS", HomeTeamId: "EW"}}},
+		}},
+	}
+	s.HandleValue(sportsdatatest.FootballBoxscorePath("2014", "reg", "1", "AUB", "KST"), &ncaafb.Boxscore{Id: "a"})
+	s.HandleValue(sportsdatatest.FootballBoxscorePath("2014", "reg", "2", "SHS", "EW"), &ncaafb.Boxscore{Id: "b"})
+	api := ncaafb.NewAPIWithOptions("key", ncaafb.WithBaseURL(s.URL), ncaafb.WithRateLimiter(nil))
+	results := api.ScheduleBoxscoresResults(schedule, []string{"b", "missing", "a"})
+	if len(results) != 3 {
+		t.Errorf("Expected %d results, found %d\n", 3, len(results))
+		return
+	}
+	for i, id := range []string{"b", "missing", "a"} {
+		if results[i].GameId != id {
+			t.Errorf("Expected game %s at %d, found %s\n", id, i, results[i].GameId)
+			return
+		}
+	}
+	if results[0].Boxscore == nil || results[0].Week != "2" || results[2].Boxscore == nil {
+		t.Errorf("Expected boxscores for scheduled games, found %+v\n", results)
+		return
+	}
+	failed := results.FailedIds()
+	if len(failed) != 1 || failed[0] != "missing" || !errors.Is(results[1].Err, sportsdata.ErrNotFound) {
+		t.Errorf("Expected game %s to fail with %v, found %+v\n", "missing", sportsdata.ErrNotFound, results[1])
+		return
+	}
+	if s.Requests("") != 2 {
+		t.Errorf("Expected %d requests, found %d\n", 2, s.Requests(""))
+		return
+	}
+}
+
+func TestScheduleBoxscoresSkipsUnscheduled(t *testing.T) {
+	s := sportsdatatest.NewServer()
+	defer s.Close()
+	schedule := &ncaafb.Schedule{
+		Year:         "2014",
+		ScheduleType: ncaafb.ScheduleRegular,
+		Season: &ncaafb.Season{Weeks: []*ncaafb.Week{
+			{Week: "1", Games: []*ncaafb.Game{{Id: "a", AwayTeamId: "AUB", HomeTeamId: "KST"}}},
+			{Week: "2", Games: []*ncaafb.Game{{Id: "b", AwayTeamId: "SHS", HomeTeamId: "EW"}}},
+		}},
+	}
+	s.HandleValue(sportsdatatest.FootballBoxscorePath("2014", "reg", "1", "AUB", "KST"), &ncaafb.Boxscore{Id: "a"})
+	s.HandleValue(sportsdatatest.FootballBoxscorePath("2014", "reg", "2", "SHS", "EW"), &ncaafb.Boxscore{Id: "b"})
+	api := ncaafb.NewAPIWithOptions("key", ncaafb.WithBaseURL(s.URL), ncaafb.WithRateLimiter(nil))
+	boxscores, err := api.ScheduleBoxscores(schedule, []string{"bowl-game", "b", "a"})
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	if len(boxscores) != 2 || boxscores[0].Id != "a" || boxscores[1].Id != "b" {
+		t.Errorf("Expected boxscores %s and %s, found %+v\n", "a", "b", boxscores)
+		return
+	}
+}
@@ -0,0 +1,51 @@
+package sportsdatatest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tassl-app/sportsdata"
+	"github.com/tassl-app/sportsdata/ncaamb"
+	"github.com/tassl-app/sportsdata/sportsdatatest"
+)
+
+func TestCacheRevalidation(t *testing.T) {
+	s := sportsdatatest.NewServer()
+	defer s.Close()
+	live := sportsdatatest.BasketballBoxscorePath(sportsdatatest.NCAAMB, "live")
+	closed := sportsdatatest.BasketballBoxscorePath(sportsdatatest.NCAAMB, "closed")
+	s.HandleValue(live, &ncaamb.Boxscore{Id: "live", Status: "inprogress"})
+	s.HandleValue(closed, &ncaamb.Boxscore{Id: "closed", Status: "closed"})
+	api := ncaamb.NewAPIWithOptions("key",
+		ncaamb.WithBaseURL(s.URL),
+		ncaamb.WithRateLimiter(nil),
+		ncaamb.WithCache(sportsdata.NewMemoryCache(10)),
+		ncaamb.WithCacheTTL(func(endpoint sportsdata.Endpoint, v interface{}) time.Duration {
+			if b, ok := v.(*ncaamb.Boxscore); ok && b.Closed() {
+				return sportsdata.CacheForever
+			}
+			return time.Nanosecond
+		}),
+	)
+	for i := 0; i < 3; i++ {
+		for _, id := range []string{"live", "closed"} {
+			boxscore, err := api.Boxscore(id)
+			if err != nil {
+				t.Errorf("Error: %s\n", err.Error())
+				return
+			}
+			if boxscore.Id != id {
+				t.Errorf("Expected boxscore id %s, found %s\n", id, boxscore.Id)
+				return
+			}
+		}
+	}
+	if s.Requests(closed) != 1 {
+		t.Errorf("Expected %d request for closed boxscore, found %d\n", 1, s.Requests(closed))
+		return
+	}
+	if s.Requests(live) != 3 {
+		t.Errorf("Expected %d requests for live boxscore, found %d\n", 3, s.Requests(live))
+		return
+	}
+}
@@ -0,0 +1,78 @@
+package sportsdatatest_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tassl-app/sportsdata/ncaafb"
+	"github.com/tassl-app/sportsdata/sportsdatatest"
+)
+
+func TestRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassette")
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	s := sportsdatatest.NewServer()
+	defer s.Close()
+	schedulePath := sportsdatatest.FootballSchedulePath("2014", "reg")
+	s.Handle(schedulePath, []byte(`<season season="2014" type="REG" comment="secret"/>`))
+
+	recorder := sportsdatatest.NewRecorder(dir, sportsdatatest.ModeRecord)
+	api := ncaafb.NewAPIWithOptions("secret", ncaafb.WithBaseURL(s.URL), ncaafb.WithHTTPClient(recorder.Client()), ncaafb.WithRateLimiter(nil))
+	_, err = api.Schedule("2014", ncaafb.ScheduleRegular)
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	recorded, err := ioutil.ReadFile(filepath.Join(dir, "ncaafb", "2014", "reg", "schedule.xml"))
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	if strings.Contains(string(recorded), "secret") {
+		t.Errorf("Expected api key to be scrubbed, found %s\n", recorded)
+		return
+	}
+	s.Close()
+
+	recorder.Mode = sportsdatatest.ModeReplay
+	schedule, err := api.Schedule("2014", ncaafb.ScheduleRegular)
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	if schedule.Season.Season != "2014" {
+		t.Errorf("Expected season %s, found %s\n", "2014", schedule.Season.Season)
+		return
+	}
+	_, err = api.Schedule("2014", ncaafb.SchedulePostSeason)
+	if !errors.Is(err, sportsdatatest.ErrNotRecorded) {
+		t.Errorf("Expected error %v, found %v\n", sportsdatatest.ErrNotRecorded, err)
+		return
+	}
+
+	replay := sportsdatatest.NewServer()
+	defer replay.Close()
+	if err := replay.LoadDir(dir); err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	if replay.Requests(schedulePath) != 0 {
+		t.Errorf("Expected no requests\n")
+		return
+	}
+	api = ncaafb.NewAPIWithOptions("key", ncaafb.WithBaseURL(replay.URL), ncaafb.WithRateLimiter(nil))
+	_, err = api.Schedule("2014", ncaafb.ScheduleRegular)
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+}
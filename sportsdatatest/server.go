@@ -0,0 +1,317 @@
+// Package sportsdatatest provides an in-process fake of the Sports Data API
+// for testing code built on the ncaafb, ncaamb and ncaawb clients.
+//
+// Point a client at the server with WithBaseURL:
+//
+//	s := sportsdatatest.NewServer()
+//	defer s.Close()
+//	s.HandleValue(sportsdatatest.FootballSchedulePath("2014", "reg"), season)
+//	api := ncaafb.NewAPIWithOptions("key", ncaafb.WithBaseURL(s.URL))
+package sportsdatatest
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	NCAAFB = "ncaafb"
+	NCAAMB = "ncaamb"
+	NCAAWB = "ncaawb"
+)
+
+// FootballHierarchyPath returns the path ncaafb.API.Division requests.
+func FootballHierarchyPath(division string) string {
+	return "/" + NCAAFB + "/teams/" + division + "/hierarchy.xml"
+}
+
+// FootballSchedulePath returns the path ncaafb.API.Schedule requests.
+func FootballSchedulePath(year, scheduleType string) string {
+	return "/" + NCAAFB + "/" + year + "/" + scheduleType + "/schedule.xml"
+}
+
+// FootballBoxscorePath returns the path ncaafb.API.Boxscore requests.
+func FootballBoxscorePath(year, scheduleType, week, awayTeamId, homeTeamId string) string {
+	return FootballGamePath(year, scheduleType, week, awayTeamId, homeTeamId, "boxscore")
+}
+
+// FootballGamePath returns the path of a per-game ncaafb feed, such as
+// "statistics" for ncaafb.API.GameStatistics.
+func FootballGamePath(year, scheduleType, week, awayTeamId, homeTeamId, feed string) string {
+	return "/" + NCAAFB + "/" + year + "/" + scheduleType + "/" + week + "/" + awayTeamId + "/" + homeTeamId + "/" + feed + ".xml"
+}
+
+// FootballTeamRosterPath returns the path ncaafb.API.TeamRoster requests.
+func FootballTeamRosterPath(teamId string) string {
+	return "/" + NCAAFB + "/teams/" + teamId + "/roster.xml"
+}
+
+// BasketballHierarchyPath returns the path League requests for sport, which
+// is NCAAMB or NCAAWB.
+func BasketballHierarchyPath(sport string) string {
+	return "/" + sport + "/league/hierarchy.xml"
+}
+
+// BasketballSchedulePath returns the path Schedule requests for sport.
+func BasketballSchedulePath(sport, season, scheduleType string) string {
+	return "/" + sport + "/games/" + season + "/" + scheduleType + "/schedule.xml"
+}
+
+// BasketballDailySchedulePath returns the path DailySchedule requests for
+// sport.
+func BasketballDailySchedulePath(sport string, date time.Time) string {
+	return "/" + sport + "/games/" + date.Format("2006/01/02") + "/schedule.xml"
+}
+
+// BasketballTeamProfilePath returns the path TeamProfile requests for sport.
+func BasketballTeamProfilePath(sport, teamId string) string {
+	return "/" + sport + "/teams/" + teamId + "/profile.xml"
+}
+
+// BasketballSeasonalStatisticsPath returns the path SeasonalStatistics
+// requests for sport.
+func BasketballSeasonalStatisticsPath(sport, season, scheduleType, teamId string) string {
+	return "/" + sport + "/seasontd/" + season + "/" + scheduleType + "/teams/" + teamId + "/statistics.xml"
+}
+
+// BasketballBoxscorePath returns the path Boxscore requests for sport.
+func BasketballBoxscorePath(sport, gameId string) string {
+	return BasketballGamePath(sport, gameId, "boxscore")
+}
+
+// BasketballGamePath returns the path of a per-game feed for sport, such as
+// "pbp" for PlayByPlay.
+func BasketballGamePath(sport, gameId, feed string) string {
+	return "/" + sport + "/games/" + gameId + "/" + feed + ".xml"
+}
+
+// JSONPath returns path with its .xml extension replaced by .json, for
+// clients created with WithFormat(sportsdata.FormatJSON).
+func JSONPath(path string) string {
+	return strings.TrimSuffix(path, ".xml") + ".json"
+}
+
+// Fault makes the server misbehave for matching requests.
+type Fault struct {
+	// Latency delays the response.
+	Latency time.Duration
+	// StatusCode, if set, is returned instead of the fixture.
+	StatusCode int
+	// RetryAfter, if set, is sent as the Retry-After header.
+	RetryAfter string
+	// Body, if set, is returned instead of the fixture.
+	Body []byte
+	// Times is the number of requests the fault applies to. Zero applies it
+	// to every request.
+	Times int
+}
+
+// RateLimited returns a fault answering times requests with 429 Too Many
+// Requests.
+func RateLimited(times int) Fault {
+	return Fault{StatusCode: http.StatusTooManyRequests, RetryAfter: "0", Times: times}
+}
+
+// ServerError returns a fault answering times requests with 500 Internal
+// Server Error.
+func ServerError(times int) Fault {
+	return Fault{StatusCode: http.StatusInternalServerError, Times: times}
+}
+
+// MalformedXML returns a fault answering times requests with a truncated
+// document.
+func MalformedXML(times int) Fault {
+	return Fault{Body: []byte(`<?xml version="1.0"?><game id="`), Times: times}
+}
+
+// Latency returns a fault delaying every response by d.
+func Latency(d time.Duration) Fault {
+	return Fault{Latency: d}
+}
+
+// accessLevelPattern matches the access level and version following the
+// sport in a request path, as in /ncaafb-t1/.
+var accessLevelPattern = regexp.MustCompile(`^/(ncaa[a-z]+)-[a-z]\d+/`)
+
+// Server is a fake Sports Data API. Fixtures are registered by path without
+// access level or version, so /ncaafb-t1/teams/FBS/hierarchy.xml and
+// /ncaafb-p1/teams/FBS/hierarchy.xml are both served by the fixture at
+// /ncaafb/teams/FBS/hierarchy.xml. Unknown paths return 404 Not Found.
+// Fixtures are served with an ETag and answer matching If-None-Match
+// requests with 304 Not Modified.
+type Server struct {
+	*httptest.Server
+	// APIKey, if set, must be sent as the api_key parameter or the server
+	// answers 403 Forbidden.
+	APIKey string
+
+	mu       sync.Mutex
+	fixtures map[string][]byte
+	faults   map[string][]*Fault
+	requests map[string]int
+}
+
+// NewServer starts and returns a new Server. The caller should call Close
+// when finished.
+func NewServer() *Server {
+	s := &Server{
+		fixtures: make(map[string][]byte),
+		faults:   make(map[string][]*Fault),
+		requests: make(map[string]int),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Handle serves body at path.
+func (s *Server) Handle(path string, body []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures[path] = body
+}
+
+// HandleValue serves v, such as an *ncaafb.Season or *ncaamb.Boxscore, at
+// path. It is encoded as JSON if path ends in .json and as XML otherwise.
+func (s *Server) HandleValue(path string, v interface{}) error {
+	var body []byte
+	var err error
+	if filepath.Ext(path) == ".json" {
+		body, err = json.Marshal(v)
+	} else {
+		body, err = xml.Marshal(v)
+	}
+	if err != nil {
+		return err
+	}
+	s.Handle(path, body)
+	return nil
+}
+
+// HandleFile serves the contents of filename at path.
+func (s *Server) HandleFile(path, filename string) error {
+	body, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	s.Handle(path, body)
+	return nil
+}
+
+// LoadDir serves every file below dir at its path relative to dir, so
+// dir/ncaafb/teams/FBS/hierarchy.xml is served at
+// /ncaafb/teams/FBS/hierarchy.xml.
+func (s *Server) LoadDir(dir string) error {
+	return filepath.Walk(dir, func(filename string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, filename)
+		if err != nil {
+			return err
+		}
+		return s.HandleFile("/"+filepath.ToSlash(rel), filename)
+	})
+}
+
+// Inject adds a fault for requests to path. An empty path applies the fault
+// to every request. Faults are applied in the order they were injected, path
+// specific faults first.
+func (s *Server) Inject(path string, f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults[path] = append(s.faults[path], &f)
+}
+
+// Requests returns the number of requests made to path, or to any path if
+// path is empty.
+func (s *Server) Requests(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[path]
+}
+
+// fault returns the next fault to apply to path, if any.
+func (s *Server) fault(path string) *Fault {
+	for _, key := range []string{path, ""} {
+		for _, f := range s.faults[key] {
+			if f.Times < 0 {
+				continue
+			}
+			if f.Times > 0 {
+				f.Times--
+				if f.Times == 0 {
+					f.Times = -1
+				}
+			}
+			return f
+		}
+	}
+	return nil
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := accessLevelPattern.ReplaceAllString(r.URL.Path, "/$1/")
+	s.mu.Lock()
+	s.requests[path]++
+	s.requests[""]++
+	f := s.fault(path)
+	body, ok := s.fixtures[path]
+	s.mu.Unlock()
+
+	if f != nil {
+		if f.Latency > 0 {
+			timer := time.NewTimer(f.Latency)
+			select {
+			case <-r.Context().Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+		if f.RetryAfter != "" {
+			w.Header().Set("Retry-After", f.RetryAfter)
+		}
+		if f.StatusCode != 0 {
+			w.WriteHeader(f.StatusCode)
+			w.Write(f.Body)
+			return
+		}
+		if f.Body != nil {
+			body, ok = f.Body, true
+		}
+	}
+	if s.APIKey != "" && r.URL.Query().Get("api_key") != s.APIKey {
+		http.Error(w, "<h1>Developer Inactive</h1>", http.StatusForbidden)
+		return
+	}
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	if filepath.Ext(path) == ".json" {
+		w.Header().Set("Content-Type", "application/json")
+	} else {
+		w.Header().Set("Content-Type", "application/xml")
+	}
+	sum := sha1.Sum(body)
+	etag := `"` + hex.EncodeToString(sum[:]) + `"`
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.Write(body)
+}
@@ -0,0 +1,181 @@
+package sportsdatatest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tassl-app/sportsdata"
+	"github.com/tassl-app/sportsdata/ncaafb"
+	"github.com/tassl-app/sportsdata/ncaamb"
+	"github.com/tassl-app/sportsdata/sportsdatatest"
+)
+
+func footballSeason() *ncaafb.Season {
+	return &ncaafb.Season{
+		Season:     "2014",
+		SeasonType: "REG",
+		Weeks: []*ncaafb.Week{
+			{
+				Week: "1",
+				Games: []*ncaafb.Game{
+					{Id: "92044ce9-3698-443d-88a9-47967462dd61", HomeTeamId: "EW", AwayTeamId: "SHS", Status: "closed"},
+				},
+			},
+		},
+	}
+}
+
+func TestServerHandleValue(t *testing.T) {
+	s := sportsdatatest.NewServer()
+	defer s.Close()
+	err := s.HandleValue(sportsdatatest.FootballSchedulePath("2014", "reg"), footballSeason())
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	api := ncaafb.NewAPIWithOptions("key", ncaafb.WithBaseURL(s.URL), ncaafb.WithProduction(true), ncaafb.WithRateLimiter(nil))
+	schedule, err := api.Schedule("2014", ncaafb.ScheduleRegular)
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	games := schedule.Games()
+	if len(games) != 1 {
+		t.Errorf("Expected %d games, found %d\n", 1, len(games))
+		return
+	}
+	if games[0].HomeTeamId != "EW" {
+		t.Errorf("Expected home team %s, found %s\n", "EW", games[0].HomeTeamId)
+		return
+	}
+	_, err = api.Schedule("2014", ncaafb.SchedulePostSeason)
+	if !errors.Is(err, sportsdata.ErrNotFound) {
+		t.Errorf("Expected error %v, found %v\n", sportsdata.ErrNotFound, err)
+		return
+	}
+}
+
+func TestServerFaults(t *testing.T) {
+	s := sportsdatatest.NewServer()
+	defer s.Close()
+	path := sportsdatatest.BasketballBoxscorePath(sportsdatatest.NCAAMB, "game")
+	err := s.HandleValue(path, &ncaamb.Boxscore{Id: "game"})
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	s.Inject(path, sportsdatatest.RateLimited(1))
+	s.Inject(path, sportsdatatest.ServerError(1))
+	retries := 0
+	api := ncaamb.NewAPIWithOptions("key",
+		ncaamb.WithBaseURL(s.URL),
+		ncaamb.WithRateLimiter(nil),
+		ncaamb.WithRetryPolicy(&sportsdata.RetryPolicy{
+			MaxAttempts: 3,
+			BaseDelay:   time.Millisecond,
+			OnRetry: func(sportsdata.RetryAttempt) {
+				retries++
+			},
+		}),
+	)
+	boxscore, err := api.Boxscore("game")
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	if boxscore.Id != "game" {
+		t.Errorf("Expected boxscore id %s, found %s\n", "game", boxscore.Id)
+		return
+	}
+	if retries != 2 || s.Requests(path) != 3 {
+		t.Errorf("Expected %d retries and %d requests, found %d and %d\n", 2, 3, retries, s.Requests(path))
+		return
+	}
+
+	s.Inject(path, sportsdatatest.MalformedXML(1))
+	_, err = api.Boxscore("game")
+	if err == nil {
+		t.Errorf("Expected malformed XML error\n")
+		return
+	}
+
+	s.Inject("", sportsdatatest.Latency(time.Second))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = api.BoxscoreContext(ctx, "game")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected error %v, found %v\n", context.DeadlineExceeded, err)
+		return
+	}
+}
+
+func TestServerAPIKey(t *testing.T) {
+	s := sportsdatatest.NewServer()
+	defer s.Close()
+	s.APIKey = "secret"
+	s.Handle(sportsdatatest.BasketballHierarchyPath(sportsdatatest.NCAAMB), []byte(`<league id="league"/>`))
+	api := ncaamb.NewAPIWithOptions("wrong", ncaamb.WithBaseURL(s.URL), ncaamb.WithRateLimiter(nil))
+	_, err := api.League()
+	if !errors.Is(err, sportsdata.ErrUnauthorized) {
+		t.Errorf("Expected error %v, found %v\n", sportsdata.ErrUnauthorized, err)
+		return
+	}
+	api = ncaamb.NewAPIWithOptions("secret", ncaamb.WithBaseURL(s.URL), ncaamb.WithRateLimiter(nil))
+	league, err := api.League()
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	if league.Id != "league" {
+		t.Errorf("Expected league id %s, found %s\n", "league", league.Id)
+		return
+	}
+}
+
+func TestServerJSON(t *testing.T) {
+	s := sportsdatatest.NewServer()
+	defer s.Close()
+	season := footballSeason()
+	season.Weeks[0].Games[0].Links = ncaafb.Links{
+		Links: []ncaafb.Link{{Rel: "boxscore", Href: "/2014/REG/1/SHS/EW/boxscore.xml"}},
+	}
+	err := s.HandleValue(sportsdatatest.JSONPath(sportsdatatest.FootballSchedulePath("2014", "reg")), season)
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	api := ncaafb.NewAPIWithOptions("key", ncaafb.WithBaseURL(s.URL), ncaafb.WithFormat(sportsdata.FormatJSON), ncaafb.WithRateLimiter(nil))
+	schedule, err := api.Schedule("2014", ncaafb.ScheduleRegular)
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	games := schedule.Games()
+	if len(games) != 1 || len(games[0].Links.Links) != 1 {
+		t.Errorf("Expected %d game with %d link, found %+v\n", 1, 1, games)
+		return
+	}
+
+	err = s.HandleValue(sportsdatatest.JSONPath(sportsdatatest.BasketballSchedulePath(sportsdatatest.NCAAMB, "2012", "reg")), &ncaamb.League{
+		SeasonSchedule: &ncaamb.SeasonSchedule{
+			Year:  "2012",
+			Games: ncaamb.Games{Games: []*ncaamb.Game{{Id: "game"}}},
+		},
+	})
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	mb := ncaamb.NewAPIWithOptions("key", ncaamb.WithBaseURL(s.URL), ncaamb.WithFormat(sportsdata.FormatJSON), ncaamb.WithRateLimiter(nil))
+	mbSchedule, err := mb.Schedule("2012", ncaamb.ScheduleRegular)
+	if err != nil {
+		t.Errorf("Error: %s\n", err.Error())
+		return
+	}
+	if len(mbSchedule.Games()) != 1 || mbSchedule.Games()[0].Id != "game" {
+		t.Errorf("Expected game %s, found %+v\n", "game", mbSchedule.Games())
+		return
+	}
+}
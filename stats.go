@@ -0,0 +1,284 @@
+package sportsdata
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Stat binds the name of a statistic, an XML attribute or JSON field, to a
+// typed field of a model. Models list their statistics as Stats and decode
+// them with UnmarshalStatsXML and UnmarshalStatsJSON, which treat empty and
+// malformed values as missing rather than failing, as the feeds leave
+// statistics blank for players who did not record them.
+type Stat struct {
+	Name   string
+	set    func(value string)
+	get    func() (value string, ok bool)
+	quoted bool
+}
+
+// IntStat binds name to v. Missing values are zero.
+func IntStat(name string, v *int64) Stat {
+	return Stat{
+		Name: name,
+		set: func(value string) {
+			if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+				*v = n
+			} else if f, err := strconv.ParseFloat(value, 64); err == nil {
+				*v = int64(f)
+			}
+		},
+		get: func() (string, bool) {
+			return strconv.FormatInt(*v, 10), true
+		},
+	}
+}
+
+// FloatStat binds name to v. Missing values are zero.
+func FloatStat(name string, v *float64) Stat {
+	return Stat{
+		Name: name,
+		set: func(value string) {
+			if f, ok := parseFloat(value); ok {
+				*v = f
+			}
+		},
+		get: func() (string, bool) {
+			return strconv.FormatFloat(*v, 'f', -1, 64), true
+		},
+	}
+}
+
+// NullFloatStat binds name to v, which is nil when the value is missing.
+func NullFloatStat(name string, v **float64) Stat {
+	return Stat{
+		Name: name,
+		set: func(value string) {
+			if f, ok := parseFloat(value); ok {
+				*v = &f
+			}
+		},
+		get: func() (string, bool) {
+			if *v == nil {
+				return "", false
+			}
+			return strconv.FormatFloat(**v, 'f', -1, 64), true
+		},
+	}
+}
+
+// PercentStat binds name to v, a percentage such as 45.5 that is nil when
+// missing, so that a player with no attempts is not shooting 0%.
+func PercentStat(name string, v **float64) Stat {
+	return NullFloatStat(name, v)
+}
+
+// TextStat binds name to v, a string such as the name of a player.
+func TextStat(name string, v *string) Stat {
+	return Stat{
+		Name: name,
+		set: func(value string) {
+			*v = value
+		},
+		get: func() (string, bool) {
+			return *v, true
+		},
+		quoted: true,
+	}
+}
+
+// MinutesStat binds name to v, playing time given as "MM:SS" or as a number
+// of minutes.
+func MinutesStat(name string, v *time.Duration) Stat {
+	return Stat{
+		Name: name,
+		set: func(value string) {
+			if d, ok := ParseMinutes(value); ok {
+				*v = d
+			}
+		},
+		get: func() (string, bool) {
+			return FormatMinutes(*v), true
+		},
+		quoted: true,
+	}
+}
+
+func parseFloat(value string) (float64, bool) {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false
+	}
+	return f, true
+}
+
+// ParseMinutes parses playing time given as "MM:SS" or as a number of
+// minutes, such as "34:12" or "34.2".
+func ParseMinutes(value string) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if i := strings.Index(value, ":"); i >= 0 {
+		minutes, err := strconv.ParseInt(value[:i], 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		seconds, err := strconv.ParseInt(value[i+1:], 10, 64)
+		if err != nil || seconds < 0 || seconds >= 60 {
+			return 0, false
+		}
+		return time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second, true
+	}
+	minutes, ok := parseFloat(value)
+	if !ok {
+		return 0, false
+	}
+	return time.Duration(minutes * float64(time.Minute)).Round(time.Second), true
+}
+
+// FormatMinutes formats d as "MM:SS".
+func FormatMinutes(d time.Duration) string {
+	seconds := int64(d.Round(time.Second) / time.Second)
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
+
+// UnmarshalStatsXML sets stats from the attributes of start and skips its
+// content.
+func UnmarshalStatsXML(d *xml.Decoder, start xml.StartElement, stats []Stat) error {
+	setStatsXML(start, stats)
+	return d.Skip()
+}
+
+// UnmarshalStatsXMLElement sets stats from the attributes of start and
+// decodes its content into v, a struct mapping only child elements.
+func UnmarshalStatsXMLElement(d *xml.Decoder, start xml.StartElement, stats []Stat, v interface{}) error {
+	setStatsXML(start, stats)
+	return d.DecodeElement(v, &start)
+}
+
+func setStatsXML(start xml.StartElement, stats []Stat) {
+	for _, attr := range start.Attr {
+		for _, stat := range stats {
+			if stat.Name == attr.Name.Local {
+				stat.set(strings.TrimSpace(attr.Value))
+				break
+			}
+		}
+	}
+}
+
+// MarshalStatsXML encodes stats as the attributes of an empty start element.
+func MarshalStatsXML(e *xml.Encoder, start xml.StartElement, stats []Stat) error {
+	start.Attr = append(start.Attr, statsXMLAttrs(stats)...)
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// MarshalStatsXMLElement encodes stats as the attributes of start and v, a
+// struct mapping only child elements, as its content.
+func MarshalStatsXMLElement(e *xml.Encoder, start xml.StartElement, stats []Stat, v interface{}) error {
+	start.Attr = append(start.Attr, statsXMLAttrs(stats)...)
+	return e.EncodeElement(v, start)
+}
+
+func statsXMLAttrs(stats []Stat) []xml.Attr {
+	attrs := make([]xml.Attr, 0, len(stats))
+	for _, stat := range stats {
+		if value, ok := stat.get(); ok {
+			attrs = append(attrs, xml.Attr{Name: xml.Name{Local: stat.Name}, Value: value})
+		}
+	}
+	return attrs
+}
+
+// UnmarshalStatsJSON sets stats from the fields of a JSON object, which may
+// be numbers or strings. Other fields are ignored.
+func UnmarshalStatsJSON(data []byte, stats []Stat) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for _, stat := range stats {
+		raw, ok := fields[stat.Name]
+		if !ok {
+			continue
+		}
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			value = string(raw)
+		}
+		stat.set(strings.TrimSpace(value))
+	}
+	return nil
+}
+
+// UnmarshalStatsJSONObject sets stats from the fields of a JSON object and
+// decodes the object into v, a struct mapping the other fields.
+func UnmarshalStatsJSONObject(data []byte, stats []Stat, v interface{}) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	return UnmarshalStatsJSON(data, stats)
+}
+
+// MarshalStatsJSON encodes stats as a JSON object, leaving out missing
+// values.
+func MarshalStatsJSON(stats []Stat) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	first := true
+	for _, stat := range stats {
+		value, ok := stat.get()
+		if !ok {
+			continue
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		name, err := json.Marshal(stat.Name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		if stat.quoted {
+			quoted, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(quoted)
+		} else {
+			buf.WriteString(value)
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// MarshalStatsJSONObject encodes stats and the fields of v, a struct, as a
+// single JSON object.
+func MarshalStatsJSONObject(stats []Stat, v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	data, err = MarshalStatsJSON(stats)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
@@ -0,0 +1,90 @@
+package sportsdata
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+type statsLine struct {
+	Minutes time.Duration
+	Points  int64
+	Ratio   float64
+	Percent *float64
+}
+
+func (s *statsLine) stats() []Stat {
+	return []Stat{
+		MinutesStat("minutes", &s.Minutes),
+		IntStat("points", &s.Points),
+		FloatStat("ratio", &s.Ratio),
+		PercentStat("pct", &s.Percent),
+	}
+}
+
+func (s *statsLine) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return UnmarshalStatsXML(d, start, s.stats())
+}
+
+func TestUnmarshalStatsXML(t *testing.T) {
+	v := new(statsLine)
+	data := `<statistics minutes="12:05" points="" ratio="bad" pct="" extra="1"/>`
+	err := xml.Unmarshal([]byte(data), v)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	expectedMinutes := 12*time.Minute + 5*time.Second
+	if v.Minutes != expectedMinutes || v.Points != 0 || v.Ratio != 0 || v.Percent != nil {
+		t.Errorf("Expected only minutes %v, found %+v\n", expectedMinutes, v)
+		return
+	}
+}
+
+func TestStatsJSON(t *testing.T) {
+	v := new(statsLine)
+	err := UnmarshalStatsJSON([]byte(`{"minutes":"30:00","points":21,"ratio":"1.5","pct":null}`), v.stats())
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if v.Minutes != 30*time.Minute || v.Points != 21 || v.Ratio != 1.5 || v.Percent != nil {
+		t.Errorf("Expected %d points in %v, found %+v\n", 21, 30*time.Minute, v)
+		return
+	}
+	data, err := MarshalStatsJSON(v.stats())
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	expected := `{"minutes":"30:00","points":21,"ratio":1.5}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, found %s\n", expected, data)
+		return
+	}
+}
+
+func TestParseMinutes(t *testing.T) {
+	for value, expected := range map[string]time.Duration{
+		"34:12": 34*time.Minute + 12*time.Second,
+		"0:07":  7 * time.Second,
+		"25":    25 * time.Minute,
+		"25.5":  25*time.Minute + 30*time.Second,
+	} {
+		d, ok := ParseMinutes(value)
+		if !ok || d != expected {
+			t.Errorf("Expected %v for %q, found %v\n", expected, value, d)
+			return
+		}
+	}
+	for _, value := range []string{"", "DNP", "12:75"} {
+		if _, ok := ParseMinutes(value); ok {
+			t.Errorf("Expected %q to be missing\n", value)
+			return
+		}
+	}
+	if s := FormatMinutes(34*time.Minute + 12*time.Second); s != "34:12" {
+		t.Errorf("Expected %s, found %s\n", "34:12", s)
+		return
+	}
+}